@@ -0,0 +1,8 @@
+data "cidaas_app" "shop" {
+  client_name = "shop-frontend"
+}
+
+data "cidaas_app" "backend" {
+  client_id             = "6a1e3f1c-5b0f-4c39-9a43-2f1d1b5d8c7e"
+  include_client_secret = true
+}
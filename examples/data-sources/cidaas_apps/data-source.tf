@@ -0,0 +1,4 @@
+data "cidaas_apps" "backend_apps" {
+  client_type = "NON_INTERACTIVE"
+  app_owner   = "CLIENT"
+}
@@ -0,0 +1 @@
+data "cidaas_hooks" "all" {}
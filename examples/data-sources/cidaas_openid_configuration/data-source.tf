@@ -0,0 +1,13 @@
+data "cidaas_openid_configuration" "tenant" {}
+
+resource "kubernetes_config_map" "oidc" {
+  metadata {
+    name = "oidc"
+  }
+
+  data = {
+    OIDC_ISSUER         = data.cidaas_openid_configuration.tenant.issuer
+    OIDC_JWKS_URI       = data.cidaas_openid_configuration.tenant.jwks_uri
+    OIDC_TOKEN_ENDPOINT = data.cidaas_openid_configuration.tenant.token_endpoint
+  }
+}
@@ -0,0 +1 @@
+data "cidaas_webhook_events" "all" {}
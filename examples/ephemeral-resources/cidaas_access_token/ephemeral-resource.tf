@@ -0,0 +1,21 @@
+ephemeral "cidaas_access_token" "integration_tests" {
+  client_id     = cidaas_app.integration_tests.client_id
+  client_secret = cidaas_app.integration_tests.client_secret
+  scopes        = ["orders:read"]
+}
+
+ephemeral "cidaas_access_token" "test_user" {
+  client_id  = cidaas_app.integration_tests.client_id
+  grant_type = "password"
+  username   = "test-user@example.com"
+  password   = var.test_user_password
+  scopes     = ["openid", "profile"]
+}
+
+provider "restapi" {
+  uri = "https://api.example.com"
+
+  headers = {
+    Authorization = "Bearer ${ephemeral.cidaas_access_token.integration_tests.access_token}"
+  }
+}
@@ -0,0 +1,8 @@
+output "login_url" {
+  value = provider::cidaas::authorize_url(
+    "example.cidaas.de",
+    cidaas_app.shop.client_id,
+    "https://shop.example.com/callback",
+    ["openid", "profile", "email"],
+  )
+}
@@ -0,0 +1,4 @@
+locals {
+  # <a href="newsletter">Consent</a>
+  newsletter_label = provider::cidaas::consent_link(cidaas_registration_field.newsletter.consent_refs[0])
+}
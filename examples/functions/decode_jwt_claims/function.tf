@@ -0,0 +1,8 @@
+ephemeral "cidaas_access_token" "ci" {
+  client_id     = cidaas_app.ci.client_id
+  client_secret = cidaas_app.ci.client_secret
+}
+
+locals {
+  token_scopes = provider::cidaas::decode_jwt_claims(ephemeral.cidaas_access_token.ci.access_token).scopes
+}
@@ -0,0 +1,4 @@
+output "issuer" {
+  # "https://example.cidaas.de"
+  value = provider::cidaas::oidc_issuer("example.cidaas.de")
+}
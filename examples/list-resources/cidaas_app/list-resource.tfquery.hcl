@@ -0,0 +1,3 @@
+list "cidaas_app" "all" {
+  provider = cidaas
+}
@@ -0,0 +1,4 @@
+list "cidaas_hook" "all" {
+  provider         = cidaas
+  include_resource = true
+}
@@ -0,0 +1,3 @@
+list "cidaas_hpgroup" "all" {
+  provider = cidaas
+}
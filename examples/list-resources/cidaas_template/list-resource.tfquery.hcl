@@ -0,0 +1,13 @@
+# all templates of the tenant
+list "cidaas_template" "all" {
+  provider = cidaas
+}
+
+# only the templates of one template group
+list "cidaas_template" "default" {
+  provider = cidaas
+
+  config {
+    group_id = "default"
+  }
+}
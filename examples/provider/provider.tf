@@ -2,4 +2,37 @@ provider "cidaas" {
   host          = var.cidaas_host          # optionally use CIDAAS_HOST env var
   client_id     = var.cidaas_client_id     # optionally use CIDAAS_CLIENT_ID env var
   client_secret = var.cidaas_client_secret # optionally use CIDAAS_CLIENT_SECRET env var
-}
\ No newline at end of file
+}
+
+# alternatively use a token issued by another process, the file is read again once the token expired
+provider "cidaas" {
+  alias             = "ci"
+  host              = var.cidaas_host
+  access_token_file = "/run/secrets/cidaas-token" # optionally use CIDAAS_ACCESS_TOKEN_FILE or CIDAAS_ACCESS_TOKEN env vars
+}
+
+# or authenticate the client with a signed JWT instead of a client secret
+provider "cidaas" {
+  alias                      = "jwt"
+  host                       = var.cidaas_host
+  client_id                  = var.cidaas_client_id
+  client_private_key_file    = "${path.module}/terraform-client.pem" # optionally use CIDAAS_CLIENT_PRIVATE_KEY_FILE or CIDAAS_CLIENT_PRIVATE_KEY env vars
+  client_key_id              = "terraform-2024"
+  client_assertion_algorithm = "RS256"
+}
+
+# or read host and credentials from a profile in ~/.cidaas/credentials
+provider "cidaas" {
+  alias   = "prod"
+  profile = "prod" # optionally use CIDAAS_PROFILE env var
+}
+
+# behind a corporate proxy with TLS inspection
+provider "cidaas" {
+  alias               = "proxied"
+  profile             = "prod"
+  proxy_url           = "http://proxy.example.com:3128" # optionally use CIDAAS_PROXY_URL env var
+  ca_certificate_file = "/etc/ssl/certs/corporate-ca.pem"
+
+  request_timeout_in_seconds = 60
+}
@@ -0,0 +1,19 @@
+resource "cidaas_hpgroup" "shop" {
+  id             = "shop"
+  default_locale = "en-US"
+  group_owner    = "CLIENT"
+
+  hosted_pages = {
+    "login/en-US" = {
+      url         = "https://shop.example.com/login"
+      source_file = "${path.module}/pages/login.html"
+      template_vars = {
+        brand = "Example Shop"
+      }
+    }
+    "error/en-US" = {
+      url     = "https://shop.example.com/error"
+      content = "<html><body>Something went wrong</body></html>"
+    }
+  }
+}
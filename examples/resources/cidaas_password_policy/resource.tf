@@ -1,3 +1,17 @@
 resource "cidaas_password_policy" "sample-policy" {
-  name = "Sample Policy"
-}
\ No newline at end of file
+  policy_name          = "Sample Policy"
+  minimum_length       = 12
+  maximum_length       = 128
+  no_of_digits         = 1
+  no_of_special_chars  = 1
+  lower_and_upper_case = true
+
+  reuse_limit        = 5
+  expiration_in_days = 180
+  block_compromised  = true
+  disallow_username  = true
+  disallow_email     = true
+
+  max_failed_attempts         = 10
+  lockout_duration_in_minutes = 15
+}
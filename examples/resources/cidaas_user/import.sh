@@ -0,0 +1 @@
+terraform import cidaas_user.e2e-customer 7a3c6f2e-1b4d-4c8e-9f0a-2d5e6b7c8d9e
\ No newline at end of file
@@ -0,0 +1,19 @@
+resource "cidaas_user" "e2e-customer" {
+  email          = "e2e-customer@example.com"
+  email_verified = true
+  password       = var.e2e_customer_password
+  given_name     = "E2E"
+  family_name    = "Customer"
+  roles          = ["USER", "CUSTOMER"]
+
+  groups = [
+    {
+      group_id = "e2e-testers"
+      roles    = ["MEMBER"]
+    },
+  ]
+
+  custom_fields = {
+    customer_number = "E2E-0001"
+  }
+}
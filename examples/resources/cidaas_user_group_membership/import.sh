@@ -0,0 +1 @@
+terraform import cidaas_user_group_membership.operations operations
\ No newline at end of file
@@ -0,0 +1,15 @@
+resource "cidaas_user_group_membership" "operations" {
+  group_id = "operations"
+  mode     = "exclusive"
+
+  members = [
+    {
+      sub   = cidaas_user.e2e-customer.id
+      roles = ["MEMBER"]
+    },
+    {
+      sub   = "2c9a4b7e-5d3f-4e8a-b1c6-7f0d9e2a3b4c"
+      roles = ["ADMIN", "MEMBER"]
+    },
+  ]
+}
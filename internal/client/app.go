@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"golang.org/x/exp/slices"
 	"net/http"
 )
 
@@ -12,6 +13,19 @@ type appResponse struct {
 	Data   App `json:"data"`
 }
 
+type appsResponse struct {
+	Status int   `json:"status"`
+	Data   []App `json:"data"`
+}
+
+type appListRequest struct {
+	Skip int `json:"skip"`
+	Take int `json:"take"`
+}
+
+// appListPageSize is the number of apps requested per page when listing all apps of a tenant
+const appListPageSize = 50
+
 func (c *client) CreateApp(app *App) (*App, error) {
 	rb, err := json.Marshal(app)
 	if err != nil {
@@ -80,6 +94,67 @@ func (c *client) GetApp(clientId string) (*App, error) {
 	return &response.Data, err
 }
 
+func (c *client) ListApps() ([]App, error) {
+	apps := []App{}
+
+	var previousPage []string
+
+	for skip := 0; ; skip += appListPageSize {
+		rb, err := json.Marshal(appListRequest{Skip: skip, Take: appListPageSize})
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequest(
+			http.MethodPost,
+			fmt.Sprintf("%s/apps-srv/clients/list", c.HostUrl),
+			bytes.NewReader(rb),
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Add("content-type", "application/json")
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var response appsResponse
+		err = json.Unmarshal(body, &response)
+
+		if err != nil {
+			return nil, err
+		}
+
+		page := make([]string, len(response.Data))
+
+		for i := range response.Data {
+			err = c.prepareResponse(&response.Data[i])
+
+			if err != nil {
+				return nil, err
+			}
+
+			page[i] = response.Data[i].ClientId
+		}
+
+		// a page repeating the previous one means skip and take are ignored, listing would never end otherwise
+		if len(page) > 0 && slices.Equal(page, previousPage) {
+			return apps, nil
+		}
+
+		apps = append(apps, response.Data...)
+		previousPage = page
+
+		if len(response.Data) < appListPageSize {
+			return apps, nil
+		}
+	}
+}
+
 func (c *client) UpdateApp(app App) (*App, error) {
 	rb, err := json.Marshal(app)
 	if err != nil {
@@ -189,5 +264,33 @@ func (c *client) prepareResponse(app *App) error {
 		app.AllowedLogoutUrls = []string{}
 	}
 
+	if app.LoginProviders == nil {
+		app.LoginProviders = []string{}
+	}
+
+	if app.Mfa != nil && app.Mfa.AllowedMethods == nil {
+		app.Mfa.AllowedMethods = []string{}
+	}
+
+	if app.GroupSelection != nil {
+		if app.GroupSelection.SelectableGroups == nil {
+			app.GroupSelection.SelectableGroups = []string{}
+		}
+
+		if app.GroupSelection.SelectableGroupTypes == nil {
+			app.GroupSelection.SelectableGroupTypes = []string{}
+		}
+	}
+
+	if app.SuggestVerificationMethods != nil {
+		if app.SuggestVerificationMethods.MandatoryConfig.Methods == nil {
+			app.SuggestVerificationMethods.MandatoryConfig.Methods = []string{}
+		}
+
+		if app.SuggestVerificationMethods.OptionalConfig.Methods == nil {
+			app.SuggestVerificationMethods.OptionalConfig.Methods = []string{}
+		}
+	}
+
 	return nil
 }
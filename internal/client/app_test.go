@@ -0,0 +1,60 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestListAppsStopsWhenPagingIsIgnored(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		// every request gets the same full page, whatever skip and take are
+		response := appsResponse{Status: 200, Data: make([]App, appListPageSize)}
+		for i := range response.Data {
+			response.Data[i].ClientId = fmt.Sprintf("app-%d", i)
+		}
+
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	c, err := NewClient(Config{Host: server.URL, AccessToken: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+
+	var apps []App
+
+	go func() {
+		apps, err = c.ListApps()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("ListApps didn't return after %d requests", requests.Load())
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(apps) != appListPageSize {
+		t.Errorf("expected %d apps, got %d", appListPageSize, len(apps))
+	}
+
+	if requests.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", requests.Load())
+	}
+}
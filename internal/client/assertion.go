@@ -0,0 +1,190 @@
+package client
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// clientAssertionLifetime is how long a client assertion is accepted by cidaas, it is only used once
+const clientAssertionLifetime = 5 * time.Minute
+
+// ClientAssertionAlgorithms are the signing algorithms supported for private_key_jwt client authentication
+var ClientAssertionAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+type assertionSigner struct {
+	key       crypto.Signer
+	keyId     string
+	algorithm string
+}
+
+func newAssertionSigner(privateKey string, keyId string, algorithm string) (*assertionSigner, error) {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return nil, errors.New("private key is not PEM encoded")
+	}
+
+	key, err := parsePrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if algorithm == "" {
+		algorithm = "RS256"
+		if _, ok := key.(*ecdsa.PrivateKey); ok {
+			algorithm = "ES256"
+		}
+	}
+
+	// checked before looking at the prefix, the algorithm may come from env vars or profiles that the schema doesn't
+	// validate
+	if _, err := algorithmHash(algorithm); err != nil {
+		return nil, err
+	}
+
+	switch algorithm[:2] {
+	case "RS", "PS":
+		if _, ok := key.(*rsa.PrivateKey); !ok {
+			return nil, fmt.Errorf("algorithm %s requires an RSA private key", algorithm)
+		}
+	case "ES":
+		if _, ok := key.(*ecdsa.PrivateKey); !ok {
+			return nil, fmt.Errorf("algorithm %s requires an EC private key", algorithm)
+		}
+	}
+
+	return &assertionSigner{key: key, keyId: keyId, algorithm: algorithm}, nil
+}
+
+func parsePrivateKey(der []byte) (crypto.Signer, error) {
+	if key, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, errors.New("unsupported private key type")
+		}
+
+		return signer, nil
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+
+	return nil, errors.New("could not parse private key, expected a PKCS#8, PKCS#1 or EC private key")
+}
+
+func algorithmHash(algorithm string) (crypto.Hash, error) {
+	switch algorithm {
+	case "RS256", "PS256", "ES256":
+		return crypto.SHA256, nil
+	case "RS384", "PS384", "ES384":
+		return crypto.SHA384, nil
+	case "RS512", "PS512", "ES512":
+		return crypto.SHA512, nil
+	}
+
+	return 0, fmt.Errorf("unsupported signing algorithm %s", algorithm)
+}
+
+// assertion builds a signed JWT identifying the client towards the token endpoint (RFC 7523)
+func (s *assertionSigner) assertion(clientId string, audience string) (string, error) {
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+
+	header := map[string]string{"alg": s.algorithm, "typ": "JWT"}
+	if s.keyId != "" {
+		header["kid"] = s.keyId
+	}
+
+	claims := map[string]any{
+		"iss": clientId,
+		"sub": clientId,
+		"aud": audience,
+		"jti": hex.EncodeToString(jti),
+		"iat": now.Unix(),
+		"exp": now.Add(clientAssertionLifetime).Unix(),
+	}
+
+	encodedHeader, err := encodeSegment(header)
+	if err != nil {
+		return "", err
+	}
+
+	encodedClaims, err := encodeSegment(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := encodedHeader + "." + encodedClaims
+
+	signature, err := s.sign([]byte(signingInput))
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func (s *assertionSigner) sign(input []byte) ([]byte, error) {
+	hash, err := algorithmHash(s.algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	h := hash.New()
+	h.Write(input)
+	digest := h.Sum(nil)
+
+	switch key := s.key.(type) {
+	case *rsa.PrivateKey:
+		if strings.HasPrefix(s.algorithm, "PS") {
+			return rsa.SignPSS(rand.Reader, key, hash, digest, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		}
+
+		return rsa.SignPKCS1v15(rand.Reader, key, hash, digest)
+	case *ecdsa.PrivateKey:
+		r, sig, err := ecdsa.Sign(rand.Reader, key, digest)
+		if err != nil {
+			return nil, err
+		}
+
+		// JWS uses the fixed size concatenation of r and s instead of ASN.1
+		size := (key.Curve.Params().BitSize + 7) / 8
+		signature := make([]byte, 2*size)
+		r.FillBytes(signature[:size])
+		sig.FillBytes(signature[size:])
+
+		return signature, nil
+	}
+
+	return nil, errors.New("unsupported private key type")
+}
+
+func encodeSegment(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -9,26 +9,68 @@ import (
 )
 
 func (c *client) SignIn() (*authResponse, error) {
-	rb, err := json.Marshal(c.Credentials)
+	tokenUrl := fmt.Sprintf("%s/token-srv/token", c.HostUrl)
+	credentials := c.Credentials
+
+	if c.signer != nil {
+		assertion, err := c.signer.assertion(credentials.ClientId, tokenUrl)
+		if err != nil {
+			return nil, fmt.Errorf("could not sign client assertion: %w", err)
+		}
+
+		credentials.ClientAssertionType = clientAssertionType
+		credentials.ClientAssertion = assertion
+	}
+
+	var response authResponse
+
+	if err := c.postToken(tokenUrl, credentials, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// RequestToken performs a client_credentials or password grant for an app of the tenant. Only the host and transport
+// settings of config are used, so no credentials of the provider are needed.
+func RequestToken(config Config, request TokenRequest) (*TokenResponse, error) {
+	httpClient, err := newHTTPClient(config)
 	if err != nil {
 		return nil, err
 	}
 
+	c := client{HTTPClient: httpClient, HostUrl: config.Host}
+
+	var response TokenResponse
+
+	if err := c.postToken(fmt.Sprintf("%s/token-srv/token", c.HostUrl), request, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *client) postToken(tokenUrl string, body interface{}, response interface{}) error {
+	rb, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
 	req, err := http.NewRequest(
 		http.MethodPost,
-		fmt.Sprintf("%s/token-srv/token", c.HostUrl),
+		tokenUrl,
 		bytes.NewReader(rb),
 	)
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	req.Header.Add("content-type", "application/json")
 
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	defer func(Body io.ReadCloser) {
@@ -38,14 +80,11 @@ func (c *client) SignIn() (*authResponse, error) {
 	if res.StatusCode > http.StatusOK {
 		b, err := io.ReadAll(res.Body)
 		if err != nil {
-			return nil, fmt.Errorf("auth failed: unknown error")
+			return fmt.Errorf("auth failed: unknown error")
 		}
 
-		return nil, fmt.Errorf("auth failed: %s", b)
+		return fmt.Errorf("auth failed: %s", b)
 	}
 
-	var response authResponse
-	err = json.NewDecoder(res.Body).Decode(&response)
-
-	return &response, err
+	return json.NewDecoder(res.Body).Decode(response)
 }
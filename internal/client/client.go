@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -9,31 +11,89 @@ import (
 
 var _ Client = (*client)(nil)
 
-func NewClient(host *string, clientId *string, clientSecret *string) (Client, error) {
-	c := client{
-		HTTPClient: &http.Client{Timeout: 30 * time.Second},
-		HostUrl:    *host,
-	}
-
-	c.Credentials = authStruct{
-		GrantType:    "client_credentials",
-		ClientId:     *clientId,
-		ClientSecret: *clientSecret,
-	}
+// Config holds the settings used to connect to a cidaas tenant.
+// If neither AccessToken nor AccessTokenFile are set, a token is requested with the client credentials. The client
+// authenticates with a signed JWT (private_key_jwt) instead of the ClientSecret if a PrivateKey is set.
+type Config struct {
+	Host            string
+	ClientId        string
+	ClientSecret    string
+	AccessToken     string
+	AccessTokenFile string
+
+	PrivateKey       string
+	PrivateKeyId     string
+	SigningAlgorithm string
+
+	// ProxyUrl overrides the HTTP_PROXY/HTTPS_PROXY env vars
+	ProxyUrl string
+	// CACertificate contains PEM encoded certificates trusted in addition to the system pool
+	CACertificate string
+	// ClientCertificate and ClientCertificateKey are PEM encoded and presented to cidaas for mTLS
+	ClientCertificate    string
+	ClientCertificateKey string
+	InsecureSkipVerify   bool
+	// Timeout of a single request, defaults to 30 seconds
+	Timeout time.Duration
+}
 
-	res, err := c.SignIn()
+// HasCredentials reports whether config contains credentials to sign in to the tenant with
+func (config Config) HasCredentials() bool {
+	return config.ClientId != "" || config.AccessToken != "" || config.AccessTokenFile != ""
+}
 
+func NewClient(config Config) (Client, error) {
+	httpClient, err := newHTTPClient(config)
 	if err != nil {
 		return nil, err
 	}
 
-	c.Token = res.Token
+	c := client{
+		HTTPClient: httpClient,
+		HostUrl:    config.Host,
+	}
+
+	switch {
+	case config.AccessToken != "":
+		c.tokens = staticToken(config.AccessToken)
+	case config.AccessTokenFile != "":
+		tokens, err := newFileToken(config.AccessTokenFile)
+		if err != nil {
+			return nil, err
+		}
+
+		c.tokens = tokens
+	default:
+		c.Credentials = authStruct{
+			GrantType:    "client_credentials",
+			ClientId:     config.ClientId,
+			ClientSecret: config.ClientSecret,
+		}
+
+		if config.PrivateKey != "" {
+			signer, err := newAssertionSigner(config.PrivateKey, config.PrivateKeyId, config.SigningAlgorithm)
+			if err != nil {
+				return nil, fmt.Errorf("invalid client private key: %w", err)
+			}
+
+			c.Credentials.ClientSecret = ""
+			c.signer = signer
+		}
+
+		res, err := c.SignIn()
+
+		if err != nil {
+			return nil, err
+		}
+
+		c.tokens = staticToken(res.Token)
+	}
 
 	return &c, nil
 }
 
 type Client interface {
-	GetHooks() ([]*Hook, error)
+	GetHooks(ctx context.Context) ([]*Hook, error)
 	GetHook(ID string) (*Hook, error)
 	UpsertHook(hook Hook) (*Hook, error)
 	DeleteHook(ID string) error
@@ -47,51 +107,92 @@ type Client interface {
 	UpdatePasswordPolicy(policy PasswordPolicy) (*PasswordPolicy, error)
 	GetPasswordPolicy(id string) (*PasswordPolicy, error)
 	GetPasswordPolicyByName(name string) (*PasswordPolicy, error)
+	ListPasswordPolicies() ([]PasswordPolicy, error)
 	DeletePasswordPolicy(id string) error
 
 	GetTenantInfo() (*TenantInfo, error)
+	GetOpenIDConfiguration() (*OpenIDConfiguration, error)
 
 	UpsertHostedPagesGroup(group HostedPageGroup) (*HostedPageGroup, error)
 	DeleteHostedPagesGroup(id string) error
 	GetHostedPagesGroup(id string) (*HostedPageGroup, error)
+	ListHostedPagesGroups() ([]HostedPageGroup, error)
 
 	CreateApp(app *App) (*App, error)
 	GetApp(ClientId string) (*App, error)
+	ListApps() ([]App, error)
 	UpdateApp(app App) (*App, error)
 	DeleteApp(ID string) error
 
 	GetRegistrationField(key string) (*RegistrationField, error)
 	UpsertRegistrationField(field *RegistrationField) error
 	DeleteRegistrationField(key string) error
+	ListRegistrationFields() ([]RegistrationField, error)
 
 	CreateTemplateGroup(group string) (*TemplateGroup, error)
 	GetTemplateGroup(groupId string) (*TemplateGroup, error)
 	UpdateTemplateGroup(group *TemplateGroup) error
 	DeleteTemplateGroup(groupId string) error
+	ListTemplateGroups() ([]TemplateGroup, error)
+
+	CreateUser(user User) (*User, error)
+	GetUser(sub string) (*User, error)
+	UpdateUser(user User) error
+	DeleteUser(sub string) error
+
+	GetUserGroups(sub string) ([]UserGroupMembership, error)
+	ListGroupMembers(groupId string) ([]GroupMembersUser, error)
+	AddUserToGroup(groupId string, sub string, roles []string) error
+	RemoveUserFromGroup(groupId string, sub string) error
 
 	UpdateTemplate(template Template) (*Template, error)
 	GetTemplate(template Template) (*Template, error)
+	ListTemplates(groupId string) ([]Template, error)
 }
 
 type client struct {
 	HTTPClient  *http.Client
 	HostUrl     string
-	Token       string
 	Credentials authStruct
+
+	signer *assertionSigner
+	tokens tokenSource
 }
 
 type authStruct struct {
-	GrantType    string `json:"grant_type"`
-	ClientId     string `json:"client_id"`
-	ClientSecret string `json:"client_secret"`
+	GrantType           string `json:"grant_type"`
+	ClientId            string `json:"client_id"`
+	ClientSecret        string `json:"client_secret,omitempty"`
+	ClientAssertionType string `json:"client_assertion_type,omitempty"`
+	ClientAssertion     string `json:"client_assertion,omitempty"`
 }
 
 type authResponse struct {
 	Token string `json:"access_token"`
 }
 
+type TokenRequest struct {
+	GrantType    string `json:"grant_type"`
+	ClientId     string `json:"client_id"`
+	ClientSecret string `json:"client_secret,omitempty"`
+	Username     string `json:"username,omitempty"`
+	Password     string `json:"password,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	IdToken     string `json:"id_token"`
+	Scope       string `json:"scope"`
+}
+
 func (c *client) doRequest(req *http.Request) ([]byte, error) {
-	token := c.Token
+	token, err := c.tokens.Token()
+	if err != nil {
+		return nil, err
+	}
 
 	req.Header.Set("Authorization", "Bearer "+token)
 
@@ -118,5 +219,22 @@ func (c *client) doRequest(req *http.Request) ([]byte, error) {
 		return nil, err
 	}
 
-	return nil, fmt.Errorf("status %d, body %s", res.StatusCode, body)
+	return nil, &StatusError{StatusCode: res.StatusCode, Body: body}
+}
+
+// StatusError is returned for requests cidaas answers with an unexpected status code
+type StatusError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("status %d, body %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether err is a response of cidaas saying the requested entity doesn't exist
+func IsNotFound(err error) bool {
+	var statusError *StatusError
+
+	return errors.As(err, &statusError) && statusError.StatusCode == http.StatusNotFound
 }
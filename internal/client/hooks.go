@@ -2,11 +2,17 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"golang.org/x/sync/errgroup"
 )
 
+// hookFetchConcurrency limits the number of hooks that are fetched in parallel by GetHooks
+const hookFetchConcurrency = 8
+
 type hookResponse struct {
 	Status int  `json:"status"`
 	Data   Hook `json:"data"`
@@ -17,8 +23,9 @@ type hooksResponse struct {
 	Data   []Hook `json:"data"`
 }
 
-func (c *client) GetHooks() ([]*Hook, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/webhooks-srv/webhook/list", c.HostUrl), nil)
+// GetHooks lists all hooks with their details. Cancelling ctx aborts the outstanding requests.
+func (c *client) GetHooks(ctx context.Context) ([]*Hook, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/webhook-srv/webhook/list", c.HostUrl), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -38,20 +45,41 @@ func (c *client) GetHooks() ([]*Hook, error) {
 
 	hooks := make([]*Hook, len(response.Data))
 
+	// The list endpoint does not return all details, so each hook is fetched individually. The first failing
+	// request cancels all outstanding ones.
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(hookFetchConcurrency)
+
 	for i, h := range response.Data {
-		var err error
-		hooks[i], err = c.GetHook(h.Id)
+		i, id := i, h.Id
+
+		g.Go(func() error {
+			hook, err := c.getHook(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			hooks[i] = hook
+
+			return nil
+		})
+	}
 
-		if err != nil {
-			return nil, err
-		}
+	err = g.Wait()
+	if err != nil {
+		return nil, err
 	}
 
 	return hooks, nil
 }
 
 func (c *client) GetHook(ID string) (*Hook, error) {
-	req, err := http.NewRequest(
+	return c.getHook(context.Background(), ID)
+}
+
+func (c *client) getHook(ctx context.Context, ID string) (*Hook, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf("%s/webhook-srv/webhook?id=%s", c.HostUrl, ID),
 		nil,
@@ -88,3 +88,36 @@ func (c *client) DeleteHostedPagesGroup(id string) error {
 
 	return err
 }
+
+type hpgroupsResponse struct {
+	Status int
+	Data   []HostedPageGroup
+}
+
+func (c *client) ListHostedPagesGroups() ([]HostedPageGroup, error) {
+	req, err := http.NewRequest(
+		http.MethodGet,
+		fmt.Sprintf("%s/hostedpages-srv/hpgroup/list", c.HostUrl),
+		nil,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var response hpgroupsResponse
+
+	err = json.Unmarshal(body, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
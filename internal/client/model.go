@@ -7,14 +7,33 @@ type TenantInfo struct {
 	VersionInfo        string `json:"versionInfo"`
 }
 
+type OpenIDConfiguration struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JwksUri                           string   `json:"jwks_uri"`
+	EndSessionEndpoint                string   `json:"end_session_endpoint"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	IdTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
 type Hook struct {
-	Id            string            `json:"_id,omitempty"`
-	AuthType      string            `json:"auth_type,omitempty"`
-	Events        []string          `json:"events"`
-	URL           string            `json:"url"`
-	CreatedTime   string            `json:"createdTime,omitempty"`
-	UpdatedTime   string            `json:"updatedTime,omitempty"`
-	ApiKeyDetails HookApiKeyDetails `json:"apikeyDetails,omitempty"`
+	Id                string                `json:"_id,omitempty"`
+	AuthType          string                `json:"auth_type,omitempty"`
+	Events            []string              `json:"events"`
+	URL               string                `json:"url"`
+	CreatedTime       string                `json:"createdTime,omitempty"`
+	UpdatedTime       string                `json:"updatedTime,omitempty"`
+	ApiKeyDetails     HookApiKeyDetails     `json:"apikeyDetails,omitempty"`
+	TotpDetails       HookTotpDetails       `json:"totpDetails,omitempty"`
+	CidaasAuthDetails HookCidaasAuthDetails `json:"cidaasAuthDetails,omitempty"`
 }
 
 type HookApiKeyDetails struct {
@@ -23,6 +42,17 @@ type HookApiKeyDetails struct {
 	APIKeyPlaceholder string `json:"apikey_placeholder,omitempty"`
 }
 
+type HookTotpDetails struct {
+	TotpPlacement   string `json:"totp_placement,omitempty"`
+	TotpPlaceholder string `json:"totp_placeholder,omitempty"`
+	TotpKey         string `json:"totpkey,omitempty"`
+}
+
+type HookCidaasAuthDetails struct {
+	ClientId string   `json:"client_id,omitempty"`
+	Scopes   []string `json:"scopes,omitempty"`
+}
+
 type SocialProvider struct {
 	Id           string `json:"id,omitempty"`
 	SocialId     string `json:"social_id"`
@@ -43,6 +73,16 @@ type PasswordPolicy struct {
 	NoOfDigits        int64  `json:"noOfDigits"`
 	LowerAndUpperCase bool   `json:"lowerAndUpperCase"`
 	NoOfSpecialChars  int64  `json:"noOfSpecialChars"`
+
+	// optional settings are left out of requests if they aren't configured, so cidaas keeps its defaults for them
+	MaximumLength            *int64 `json:"maximumLength,omitempty"`
+	ReuseLimit               *int64 `json:"reuseLimit,omitempty"`
+	ExpirationInDays         *int64 `json:"expirationInDays,omitempty"`
+	BlockCompromised         *bool  `json:"blockCompromised,omitempty"`
+	DisallowUsername         *bool  `json:"disallowUsername,omitempty"`
+	DisallowEmail            *bool  `json:"disallowEmail,omitempty"`
+	MaxFailedAttempts        *int64 `json:"maxFailedAttempts,omitempty"`
+	LockoutDurationInMinutes *int64 `json:"lockoutDurationInMinutes,omitempty"`
 }
 
 type HostedPage struct {
@@ -73,6 +113,29 @@ type AllowedGroup struct {
 	DefaultRoles []string `json:"default_roles" tfsdk:"default_roles"`
 }
 
+type AppMfa struct {
+	Setting               string   `json:"setting" tfsdk:"setting"`
+	TimeIntervalInSeconds int64    `json:"time_interval_in_seconds" tfsdk:"time_interval_in_seconds"`
+	AllowedMethods        []string `json:"allowed_methods" tfsdk:"allowed_methods"`
+}
+
+type GroupSelection struct {
+	AlwaysShowGroupSelection bool     `json:"alwaysShowGroupSelection" tfsdk:"always_show_group_selection"`
+	SelectableGroups         []string `json:"selectableGroups" tfsdk:"selectable_groups"`
+	SelectableGroupTypes     []string `json:"selectableGroupTypes" tfsdk:"selectable_group_types"`
+}
+
+type VerificationMethodsConfig struct {
+	Methods []string `json:"methods" tfsdk:"methods"`
+	Range   string   `json:"range" tfsdk:"range"`
+}
+
+type SuggestVerificationMethods struct {
+	MandatoryConfig    VerificationMethodsConfig `json:"mandatory_config" tfsdk:"mandatory_config"`
+	OptionalConfig     VerificationMethodsConfig `json:"optional_config" tfsdk:"optional_config"`
+	SkipDurationInDays int64                     `json:"skip_duration_in_days" tfsdk:"skip_duration_in_days"`
+}
+
 type App struct {
 	ID                              string  `json:"id,omitempty"`
 	AcceptRolesInTheRegistration    bool    `json:"accept_roles_in_the_registration"`
@@ -107,10 +170,20 @@ type App struct {
 	RegisterWithLoginInformation    bool    `json:"register_with_login_information"`
 	AppOwner                        string  `json:"app_owner,omitempty"`
 	BotProvider                     string  `json:"bot_provider,omitempty"`
+	EnforcePkce                     *bool   `json:"enforce_pkce,omitempty"`
+	EnableRefreshTokenRotation      *bool   `json:"enable_refresh_token_rotation,omitempty"`
+	RefreshTokenReuseInterval       *int64  `json:"refresh_token_reuse_interval_in_seconds,omitempty"`
+	LogoUri                         string  `json:"logo_uri,omitempty"`
+	BackgroundUri                   string  `json:"background_uri,omitempty"`
+	ConsentPageGroup                string  `json:"consent_page_group,omitempty"`
 
-	AppKey *AppKey `json:"appKey,omitempty"`
+	AppKey                     *AppKey                     `json:"appKey,omitempty"`
+	Mfa                        *AppMfa                     `json:"mfa,omitempty"`
+	GroupSelection             *GroupSelection             `json:"group_selection,omitempty"`
+	SuggestVerificationMethods *SuggestVerificationMethods `json:"suggest_verification_methods,omitempty"`
 
 	AllowLoginWith               []string         `json:"allow_login_with"`
+	LoginProviders               []string         `json:"login_providers,omitempty"`
 	OperationsAllowedGroups      []AllowedGroup   `json:"operations_allowed_groups"`
 	AllowedGroups                []AllowedGroup   `json:"allowed_groups"`
 	RedirectUris                 []string         `json:"redirect_uris"`
@@ -214,3 +287,28 @@ type CustomProvider struct {
 	DisplayName  string `json:"display_name"`
 	ProviderName string `json:"provider_name"`
 }
+
+type User struct {
+	Sub                  string                 `json:"sub,omitempty"`
+	UserStatus           string                 `json:"userStatus,omitempty"`
+	Email                string                 `json:"email"`
+	EmailVerified        bool                   `json:"email_verified"`
+	MobileNumber         string                 `json:"mobile_number,omitempty"`
+	MobileNumberVerified bool                   `json:"mobile_number_verified"`
+	Username             string                 `json:"username,omitempty"`
+	Password             string                 `json:"password,omitempty"`
+	GivenName            string                 `json:"given_name,omitempty"`
+	FamilyName           string                 `json:"family_name,omitempty"`
+	Roles                []string               `json:"roles"`
+	CustomFields         map[string]interface{} `json:"customFields,omitempty"`
+}
+
+type UserGroupMembership struct {
+	GroupId string   `json:"groupId"`
+	Roles   []string `json:"roles"`
+}
+
+type GroupMembersUser struct {
+	Sub   string   `json:"sub"`
+	Roles []string `json:"roles"`
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func (c *client) GetOpenIDConfiguration() (*OpenIDConfiguration, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/.well-known/openid-configuration", c.HostUrl), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the discovery document is not wrapped in the usual status/data envelope
+	var configuration OpenIDConfiguration
+	err = json.Unmarshal(body, &configuration)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &configuration, nil
+}
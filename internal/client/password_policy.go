@@ -83,7 +83,7 @@ func (c *client) UpdatePasswordPolicy(policy PasswordPolicy) (*PasswordPolicy, e
 	return &response.Data, err
 }
 
-func (c *client) GetPasswordPolicyByName(name string) (*PasswordPolicy, error) {
+func (c *client) ListPasswordPolicies() ([]PasswordPolicy, error) {
 	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/password-policy-srv/policy/list", c.HostUrl), nil)
 
 	if err != nil {
@@ -103,11 +103,21 @@ func (c *client) GetPasswordPolicyByName(name string) (*PasswordPolicy, error) {
 		return nil, err
 	}
 
-	if len(response.Data) == 0 {
+	return response.Data, nil
+}
+
+func (c *client) GetPasswordPolicyByName(name string) (*PasswordPolicy, error) {
+	policies, err := c.ListPasswordPolicies()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policies) == 0 {
 		return nil, errors.New("could not find any policies")
 	}
 
-	for _, el := range response.Data {
+	for _, el := range policies {
 		if el.PolicyName == name {
 			return &el, nil
 		}
@@ -0,0 +1,303 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Profile holds the connection settings of a tenant as they are configured in the provider block, a profile of the
+// credentials file or the environment.
+type Profile struct {
+	Host            string `yaml:"host"`
+	ClientId        string `yaml:"client_id"`
+	ClientSecret    string `yaml:"client_secret"`
+	AccessToken     string `yaml:"access_token"`
+	AccessTokenFile string `yaml:"access_token_file"`
+
+	ClientPrivateKey         string `yaml:"client_private_key"`
+	ClientPrivateKeyFile     string `yaml:"client_private_key_file"`
+	ClientKeyId              string `yaml:"client_key_id"`
+	ClientAssertionAlgorithm string `yaml:"client_assertion_algorithm"`
+
+	ProxyUrl                 string `yaml:"proxy_url"`
+	CACertificate            string `yaml:"ca_certificate"`
+	CACertificateFile        string `yaml:"ca_certificate_file"`
+	ClientCertificate        string `yaml:"client_certificate"`
+	ClientCertificateFile    string `yaml:"client_certificate_file"`
+	ClientCertificateKey     string `yaml:"client_certificate_key"`
+	ClientCertificateKeyFile string `yaml:"client_certificate_key_file"`
+	InsecureSkipVerify       *bool  `yaml:"insecure_skip_verify"`
+	RequestTimeoutInSeconds  int64  `yaml:"request_timeout_in_seconds"`
+}
+
+// DefaultCredentialsFile returns the path of the credentials file, which can be overridden with CIDAAS_CREDENTIALS_FILE
+func DefaultCredentialsFile() (string, error) {
+	if path := os.Getenv("CIDAAS_CREDENTIALS_FILE"); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".cidaas", "credentials"), nil
+}
+
+// ProfileFromEnv reads the connection settings from the CIDAAS_* environment variables
+func ProfileFromEnv() Profile {
+	return Profile{
+		Host:                     os.Getenv("CIDAAS_HOST"),
+		ClientId:                 os.Getenv("CIDAAS_CLIENT_ID"),
+		ClientSecret:             os.Getenv("CIDAAS_CLIENT_SECRET"),
+		AccessToken:              os.Getenv("CIDAAS_ACCESS_TOKEN"),
+		AccessTokenFile:          os.Getenv("CIDAAS_ACCESS_TOKEN_FILE"),
+		ClientPrivateKey:         os.Getenv("CIDAAS_CLIENT_PRIVATE_KEY"),
+		ClientPrivateKeyFile:     os.Getenv("CIDAAS_CLIENT_PRIVATE_KEY_FILE"),
+		ClientKeyId:              os.Getenv("CIDAAS_CLIENT_KEY_ID"),
+		ClientAssertionAlgorithm: os.Getenv("CIDAAS_CLIENT_ASSERTION_ALGORITHM"),
+		ProxyUrl:                 os.Getenv("CIDAAS_PROXY_URL"),
+		CACertificateFile:        os.Getenv("CIDAAS_CA_CERTIFICATE_FILE"),
+		ClientCertificateFile:    os.Getenv("CIDAAS_CLIENT_CERTIFICATE_FILE"),
+		ClientCertificateKeyFile: os.Getenv("CIDAAS_CLIENT_CERTIFICATE_KEY_FILE"),
+	}
+}
+
+// LoadProfile reads a named profile from a credentials file. The file is either YAML with a mapping of profile names
+// to settings or INI with one section per profile:
+//
+//	[prod]
+//	host          = https://prod.cidaas.example.com
+//	client_id     = ...
+//	client_secret = ...
+func LoadProfile(path string, name string) (*Profile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read credentials file: %w", err)
+	}
+
+	var profiles map[string]Profile
+
+	if isIni(content) {
+		profiles, err = parseIniProfiles(content)
+	} else {
+		err = yaml.Unmarshal(content, &profiles)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse credentials file %s: %w", path, err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %s not found in %s", name, path)
+	}
+
+	return &profile, nil
+}
+
+func isIni(content []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		return strings.HasPrefix(line, "[")
+	}
+
+	return false
+}
+
+func parseIniProfiles(content []byte) (map[string]Profile, error) {
+	profiles := map[string]Profile{}
+	sections := map[string]*yaml.Node{}
+
+	var section *yaml.Node
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			section = &yaml.Node{Kind: yaml.MappingNode}
+			sections[name] = section
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found || section == nil {
+			return nil, fmt.Errorf("line %d: expected key = value inside a [profile] section", lineNumber)
+		}
+
+		section.Content = append(
+			section.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: strings.TrimSpace(key)},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: strings.Trim(strings.TrimSpace(value), `"`)},
+		)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// the keys are the same as in the YAML format, so the sections are decoded as plain YAML scalars
+	for name, values := range sections {
+		var profile Profile
+		if err := values.Decode(&profile); err != nil {
+			return nil, fmt.Errorf("profile %s: %w", name, err)
+		}
+
+		profiles[name] = profile
+	}
+
+	return profiles, nil
+}
+
+// ConfigFromProfile resolves the configuration of a named profile, falling back to the CIDAAS_* environment variables
+// for settings the profile doesn't set. Credentials are only read from the environment if the profile has none. An
+// empty name only uses the environment.
+func ConfigFromProfile(name string, credentialsFile string) (Config, error) {
+	profiles := []Profile{}
+
+	if name != "" {
+		if credentialsFile == "" {
+			var err error
+
+			credentialsFile, err = DefaultCredentialsFile()
+			if err != nil {
+				return Config{}, err
+			}
+		}
+
+		profile, err := LoadProfile(credentialsFile, name)
+		if err != nil {
+			return Config{}, err
+		}
+
+		profiles = append(profiles, *profile)
+	}
+
+	return ResolveConfig(append(profiles, ProfileFromEnv())...)
+}
+
+// ResolveConfig builds the client configuration from profiles ordered by precedence. Each setting is taken from the
+// first profile that sets it, except for the credentials: the client id, secret, private key and access token are
+// taken together from the first profile setting any of them. Combining them across profiles could e.g. send a leftover
+// CIDAAS_ACCESS_TOKEN of one tenant to the host of another.
+func ResolveConfig(profiles ...Profile) (Config, error) {
+	var config Config
+	var err error
+
+	for _, p := range profiles {
+		config.Host = firstNonEmpty(config.Host, p.Host)
+	}
+
+	for _, p := range profiles {
+		if !p.hasCredentials() {
+			continue
+		}
+
+		config.ClientId = p.ClientId
+		config.ClientSecret = p.ClientSecret
+		config.AccessToken = p.AccessToken
+		config.AccessTokenFile = p.AccessTokenFile
+		config.PrivateKeyId = p.ClientKeyId
+		config.SigningAlgorithm = p.ClientAssertionAlgorithm
+
+		config.PrivateKey, err = resolvePem([]Profile{p}, func(p Profile) (string, string) {
+			return p.ClientPrivateKey, p.ClientPrivateKeyFile
+		})
+		if err != nil {
+			return config, fmt.Errorf("could not read client private key: %w", err)
+		}
+
+		break
+	}
+
+	for _, p := range profiles {
+		config.ProxyUrl = firstNonEmpty(config.ProxyUrl, p.ProxyUrl)
+
+		if config.Timeout == 0 && p.RequestTimeoutInSeconds > 0 {
+			config.Timeout = time.Duration(p.RequestTimeoutInSeconds) * time.Second
+		}
+	}
+
+	for _, p := range profiles {
+		if p.InsecureSkipVerify != nil {
+			config.InsecureSkipVerify = *p.InsecureSkipVerify
+			break
+		}
+	}
+
+	config.CACertificate, err = resolvePem(profiles, func(p Profile) (string, string) { return p.CACertificate, p.CACertificateFile })
+	if err != nil {
+		return config, fmt.Errorf("could not read CA certificate: %w", err)
+	}
+
+	config.ClientCertificate, err = resolvePem(profiles, func(p Profile) (string, string) { return p.ClientCertificate, p.ClientCertificateFile })
+	if err != nil {
+		return config, fmt.Errorf("could not read client certificate: %w", err)
+	}
+
+	config.ClientCertificateKey, err = resolvePem(profiles, func(p Profile) (string, string) {
+		return p.ClientCertificateKey, p.ClientCertificateKeyFile
+	})
+	if err != nil {
+		return config, fmt.Errorf("could not read client certificate key: %w", err)
+	}
+
+	return config, nil
+}
+
+func (p Profile) hasCredentials() bool {
+	return p.ClientId != "" || p.ClientSecret != "" || p.AccessToken != "" || p.AccessTokenFile != "" ||
+		p.ClientPrivateKey != "" || p.ClientPrivateKeyFile != ""
+}
+
+// resolvePem returns the PEM content of the first profile setting it either inline or as a file
+func resolvePem(profiles []Profile, get func(Profile) (string, string)) (string, error) {
+	for _, p := range profiles {
+		inline, file := get(p)
+
+		if inline != "" {
+			return inline, nil
+		}
+
+		if file != "" {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return "", err
+			}
+
+			return string(content), nil
+		}
+	}
+
+	return "", nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
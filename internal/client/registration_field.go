@@ -39,30 +39,87 @@ func (c *client) GetRegistrationField(key string) (*RegistrationField, error) {
 		return nil, err
 	}
 
-	id := response.Data["id"].(string)
+	field := registrationFieldFromData(response.Data)
+
+	return &field, nil
+}
+
+type registrationFieldsResponse struct {
+	Status int                      `json:"status"`
+	Data   []map[string]interface{} `json:"data"`
+}
+
+func (c *client) ListRegistrationFields() ([]RegistrationField, error) {
+	req, err := http.NewRequest(
+		http.MethodGet,
+		fmt.Sprintf("%s/registration-setup-srv/fields/flat/list", c.HostUrl),
+		nil,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
 
-	consentRefsRaw := response.Data["consent_refs"].([]interface{})
+	var response registrationFieldsResponse
+	err = json.Unmarshal(body, &response)
 
-	consentRefs := make([]string, len(consentRefsRaw))
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]RegistrationField, len(response.Data))
+
+	for i := range response.Data {
+		fields[i] = registrationFieldFromData(response.Data[i])
+	}
+
+	return fields, nil
+}
+
+// registrationFieldFromData maps the flat representation cidaas returns for registration fields, attributes missing
+// on built-in fields are left empty
+func registrationFieldFromData(data map[string]interface{}) RegistrationField {
+	id, _ := data["id"].(string)
+	readOnly, _ := data["readOnly"].(bool)
+	claimable, _ := data["claimable"].(bool)
+	required, _ := data["required"].(bool)
+	enabled, _ := data["enabled"].(bool)
+	internal, _ := data["internal"].(bool)
+	parentGroupId, _ := data["parent_group_id"].(string)
+	fieldKey, _ := data["fieldKey"].(string)
+	dataType, _ := data["dataType"].(string)
+	fieldType, _ := data["fieldType"].(string)
+	order, _ := data["order"].(float64)
+
+	consentRefsRaw, _ := data["consent_refs"].([]interface{})
+
+	consentRefs := make([]string, 0, len(consentRefsRaw))
 
 	for i := range consentRefsRaw {
-		consentRefs[i] = consentRefsRaw[i].(string)
+		if ref, ok := consentRefsRaw[i].(string); ok {
+			consentRefs = append(consentRefs, ref)
+		}
 	}
 
-	field := RegistrationField{
-		ReadOnly:      response.Data["readOnly"].(bool),
-		Claimable:     response.Data["claimable"].(bool),
-		Required:      response.Data["required"].(bool),
-		Enabled:       response.Data["enabled"].(bool),
-		ParentGroupID: response.Data["parent_group_id"].(string),
+	return RegistrationField{
+		Internal:      internal,
+		ReadOnly:      readOnly,
+		Claimable:     claimable,
+		Required:      required,
+		Enabled:       enabled,
+		ParentGroupID: parentGroupId,
 		ConsentRefs:   consentRefs,
 		ID:            &id,
-		FieldKey:      response.Data["fieldKey"].(string),
-		DataType:      response.Data["dataType"].(string),
-		Order:         int64(response.Data["order"].(float64)),
+		FieldKey:      fieldKey,
+		DataType:      dataType,
+		FieldType:     fieldType,
+		Order:         int64(order),
 	}
-
-	return &field, nil
 }
 
 func (c *client) UpsertRegistrationField(field *RegistrationField) error {
@@ -138,8 +195,13 @@ func (rf *RegistrationField) calculateFields() {
 		Locale:   "de-DE",
 		Language: "de",
 		ConsentLabel: ConsentLabel{
-			Label:     fmt.Sprintf("<a href=\"%s\">Consent</a>", rf.ConsentRefs[0]),
-			LabelText: fmt.Sprintf("<a href=\"%s\">Consent</a>", rf.ConsentRefs[0]),
+			Label:     ConsentLink(rf.ConsentRefs[0]),
+			LabelText: ConsentLink(rf.ConsentRefs[0]),
 		},
 	}
 }
+
+// ConsentLink is the label cidaas shows next to the checkbox of a consent field
+func ConsentLink(ref string) string {
+	return fmt.Sprintf("<a href=\"%s\">Consent</a>", ref)
+}
@@ -78,3 +78,45 @@ func (c *client) UpdateTemplate(template Template) (*Template, error) {
 
 	return &templateResponse.Data, nil
 }
+
+type templatesResponse struct {
+	Data []Template
+}
+
+type templateListRequest struct {
+	GroupId string `json:"group_id"`
+}
+
+func (c *client) ListTemplates(groupId string) ([]Template, error) {
+	rb, err := json.Marshal(templateListRequest{GroupId: groupId})
+
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		http.MethodPost,
+		fmt.Sprintf("%s/templates-srv/template/list", c.HostUrl),
+		strings.NewReader(string(rb)),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	resp, err := c.doRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var response templatesResponse
+	err = json.Unmarshal(resp, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
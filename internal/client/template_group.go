@@ -127,3 +127,35 @@ func (c *client) DeleteTemplateGroup(groupId string) error {
 
 	return err
 }
+
+type templateGroupsResponse struct {
+	Data []TemplateGroup `json:"data"`
+}
+
+func (c *client) ListTemplateGroups() ([]TemplateGroup, error) {
+	req, err := http.NewRequest(
+		http.MethodGet,
+		fmt.Sprintf("%s/templates-srv/groups/list", c.HostUrl),
+		nil,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var response templateGroupsResponse
+
+	err = json.Unmarshal(body, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenExpiryLeeway renews tokens a bit before they expire to account for clock skew and request latency
+const tokenExpiryLeeway = 30 * time.Second
+
+// tokenSource provides the bearer token sent with every request
+type tokenSource interface {
+	Token() (string, error)
+}
+
+type staticToken string
+
+func (t staticToken) Token() (string, error) {
+	return string(t), nil
+}
+
+// fileToken reads the token from a file and reads it again once the token expired, so that it can be rotated by an
+// external process. Tokens without a readable expiry are read again on every request.
+type fileToken struct {
+	path string
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func newFileToken(path string) (*fileToken, error) {
+	t := &fileToken{path: path}
+
+	if _, err := t.Token(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *fileToken) Token() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != "" && time.Now().Add(tokenExpiryLeeway).Before(t.expiry) {
+		return t.token, nil
+	}
+
+	content, err := os.ReadFile(t.path)
+	if err != nil {
+		return "", fmt.Errorf("could not read access token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("access token file %s is empty", t.path)
+	}
+
+	t.token = token
+	t.expiry = tokenExpiry(token)
+
+	return t.token, nil
+}
+
+// tokenExpiry returns the expiry of a JWT access token without verifying it, the zero time is returned for opaque
+// tokens and tokens without an exp claim.
+func tokenExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(claims.Exp, 0)
+}
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type userResponse struct {
+	Status int  `json:"status"`
+	Data   User `json:"data"`
+}
+
+type userGroupsResponse struct {
+	Status int                   `json:"status"`
+	Data   []UserGroupMembership `json:"data"`
+}
+
+type groupUsersResponse struct {
+	Status int                `json:"status"`
+	Data   []GroupMembersUser `json:"data"`
+}
+
+type groupMembershipRequest struct {
+	Sub     string   `json:"sub"`
+	GroupId string   `json:"groupId"`
+	Roles   []string `json:"roles"`
+}
+
+func (c *client) CreateUser(user User) (*User, error) {
+	rb, err := json.Marshal(user)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		http.MethodPost,
+		fmt.Sprintf("%s/users-srv/user", c.HostUrl),
+		bytes.NewReader(rb),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response userResponse
+	err = json.Unmarshal(body, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Data, nil
+}
+
+func (c *client) GetUser(sub string) (*User, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/users-srv/user/%s", c.HostUrl, sub), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response userResponse
+	err = json.Unmarshal(body, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Data, nil
+}
+
+func (c *client) UpdateUser(user User) error {
+	rb, err := json.Marshal(user)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(
+		http.MethodPut,
+		fmt.Sprintf("%s/users-srv/user/%s", c.HostUrl, user.Sub),
+		bytes.NewReader(rb),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	_, err = c.doRequest(req)
+
+	return err
+}
+
+func (c *client) DeleteUser(sub string) error {
+	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/users-srv/user/%s", c.HostUrl, sub), nil)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+
+	return err
+}
+
+func (c *client) GetUserGroups(sub string) ([]UserGroupMembership, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/groups-srv/user/%s/groups", c.HostUrl, sub), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response userGroupsResponse
+	err = json.Unmarshal(body, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+func (c *client) ListGroupMembers(groupId string) ([]GroupMembersUser, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/groups-srv/group/%s/users", c.HostUrl, groupId), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response groupUsersResponse
+	err = json.Unmarshal(body, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// AddUserToGroup adds the user to the group, or replaces the roles of an existing membership
+func (c *client) AddUserToGroup(groupId string, sub string, roles []string) error {
+	rb, err := json.Marshal(groupMembershipRequest{Sub: sub, GroupId: groupId, Roles: roles})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(
+		http.MethodPost,
+		fmt.Sprintf("%s/groups-srv/users", c.HostUrl),
+		bytes.NewReader(rb),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	_, err = c.doRequest(req)
+
+	return err
+}
+
+func (c *client) RemoveUserFromGroup(groupId string, sub string) error {
+	req, err := http.NewRequest(
+		http.MethodDelete,
+		fmt.Sprintf("%s/groups-srv/group/%s/users/%s", c.HostUrl, groupId, sub),
+		nil,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+
+	return err
+}
@@ -0,0 +1,41 @@
+package client
+
+// WebhookEvents is the catalogue of event types that can trigger a webhook in cidaas
+var WebhookEvents = []string{
+	"ACCOUNT_CREATED",
+	"ACCOUNT_CREATED_WITH_CIDAAS_IDENTITY",
+	"ACCOUNT_CREATED_WITH_SOCIAL_IDENTITY",
+	"ACCOUNT_MODIFIED",
+	"ACCOUNT_DELETED",
+	"ACCOUNT_ACTIVATED",
+	"ACCOUNT_DEACTIVATED",
+	"ACCOUNT_DEDUPLICATED",
+	"ACCOUNT_LOCKED",
+	"ACCOUNT_UNLOCKED",
+	"SOCIAL_ACCOUNT_LINKED",
+	"SOCIAL_ACCOUNT_UNLINKED",
+	"LOGIN_WITH_CIDAAS",
+	"LOGIN_WITH_SOCIAL",
+	"LOGIN_FAILURE",
+	"LOGOUT",
+	"PASSWORD_CHANGED",
+	"PASSWORD_RESET",
+	"EMAIL_VERIFIED",
+	"MOBILE_VERIFIED",
+	"MFA_CONFIGURED",
+	"MFA_REMOVED",
+	"CONSENT_ACCEPTED",
+	"CONSENT_REVOKED",
+	"APP_CREATED",
+	"APP_MODIFIED",
+	"APP_DELETED",
+	"GROUP_CREATED",
+	"GROUP_MODIFIED",
+	"GROUP_DELETED",
+	"USER_ADDED_TO_GROUP",
+	"USER_REMOVED_FROM_GROUP",
+	"ROLE_CREATED",
+	"ROLE_DELETED",
+	"ROLE_ASSIGNED",
+	"ROLE_UNASSIGNED",
+}
@@ -0,0 +1,133 @@
+package diff
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/real-digital/terraform-provider-cidaas/internal/client"
+	"github.com/real-digital/terraform-provider-cidaas/internal/export"
+)
+
+// Run executes `terraform-provider-cidaas diff`
+func Run(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+
+	from := flags.String("from", "", "profile of the tenant to compare from")
+	to := flags.String("to", "", "profile of the tenant to compare to")
+	credentialsFile := flags.String("credentials-file", "", "path of the credentials file, defaults to ~/.cidaas/credentials")
+	format := flags.String("format", "text", "output format, text or json")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *from == "" || *to == "" {
+		return fmt.Errorf("-from and -to are required")
+	}
+
+	if *format != "text" && *format != "json" {
+		return fmt.Errorf("unsupported format %q, must be text or json", *format)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	left, err := loadTenant(ctx, *from, *credentialsFile)
+	if err != nil {
+		return err
+	}
+
+	right, err := loadTenant(ctx, *to, *credentialsFile)
+	if err != nil {
+		return err
+	}
+
+	changes, err := Compare(left, right)
+	if err != nil {
+		return err
+	}
+
+	if *format == "json" {
+		return WriteJSON(stdout, changes)
+	}
+
+	return WriteText(stdout, changes)
+}
+
+func loadTenant(ctx context.Context, profile string, credentialsFile string) (*export.Tenant, error) {
+	config, err := client.ConfigFromProfile(profile, credentialsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.NewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to %s: %w", config.Host, err)
+	}
+
+	tenant, err := export.LoadTenant(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("could not load tenant of profile %s: %w", profile, err)
+	}
+
+	return tenant, nil
+}
+
+// WriteJSON writes the changes as a JSON array
+func WriteJSON(w io.Writer, changes []Change) error {
+	if changes == nil {
+		changes = []Change{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(changes)
+}
+
+// WriteText writes the changes in a format similar to terraform plans, + for resources only in the second tenant,
+// - for resources only in the first and ~ for resources that differ
+func WriteText(w io.Writer, changes []Change) error {
+	if len(changes) == 0 {
+		_, err := fmt.Fprintln(w, "No differences.")
+		return err
+	}
+
+	symbols := map[string]string{
+		ActionAdded:   "+",
+		ActionRemoved: "-",
+		ActionChanged: "~",
+	}
+
+	for _, change := range changes {
+		if _, err := fmt.Fprintf(w, "%s %s %q\n", symbols[change.Action], change.Kind, change.Key); err != nil {
+			return err
+		}
+
+		for _, field := range change.Fields {
+			if _, err := fmt.Fprintf(w, "    %s: %s => %s\n", field.Path, textValue(field.Left), textValue(field.Right)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func textValue(value interface{}) string {
+	if value == nil {
+		return "(none)"
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(data)
+}
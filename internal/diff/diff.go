@@ -0,0 +1,314 @@
+// Package diff compares the configuration of two cidaas tenants.
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/real-digital/terraform-provider-cidaas/internal/client"
+	"github.com/real-digital/terraform-provider-cidaas/internal/export"
+)
+
+// ignoredFields are generated by cidaas and differ between tenants even if the configuration is the same. They are
+// only ignored on the top level of a resource, nested fields of the same name like the client_id of a hook's
+// cidaasAuthDetails are configuration.
+var ignoredFields = map[string]bool{
+	"_id":            true,
+	"id":             true,
+	"createdTime":    true,
+	"updatedTime":    true,
+	"client_id":      true,
+	"client_secret":  true,
+	"app_owner":      true,
+	"appKey":         true,
+	"last_seeded_by": true,
+}
+
+// sensitiveFields are secrets, like the api keys of hooks that cidaas returns unmasked. Their values are replaced on
+// every level so that they are never printed.
+var sensitiveFields = map[string]bool{
+	"apikey":        true,
+	"totpkey":       true,
+	"client_secret": true,
+	"privateKey":    true,
+	"password":      true,
+}
+
+const redacted = "(sensitive)"
+
+const (
+	ActionAdded   = "added"
+	ActionRemoved = "removed"
+	ActionChanged = "changed"
+)
+
+// Change describes how a single resource differs, Added and Removed are relative to the first tenant
+type Change struct {
+	Kind   string        `json:"kind"`
+	Key    string        `json:"key"`
+	Action string        `json:"action"`
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+type FieldChange struct {
+	Path  string      `json:"path"`
+	Left  interface{} `json:"left"`
+	Right interface{} `json:"right"`
+}
+
+type keyed struct {
+	key   string
+	value interface{}
+}
+
+// Compare returns the changes needed to turn the configuration of left into right
+func Compare(left *export.Tenant, right *export.Tenant) ([]Change, error) {
+	var changes []Change
+
+	l := resources(left)
+	r := resources(right)
+
+	for _, kind := range kinds {
+		kindChanges, err := compareKind(kind, l[kind], r[kind])
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, kindChanges...)
+	}
+
+	return changes, nil
+}
+
+var kinds = []string{
+	"app",
+	"hook",
+	"hosted_page_group",
+	"template_group",
+	"template",
+	"password_policy",
+	"registration_field",
+}
+
+// resources keys everything in the tenant by what identifies it across tenants, ids are generated per tenant
+func resources(tenant *export.Tenant) map[string][]keyed {
+	result := map[string][]keyed{}
+
+	for _, app := range tenant.Apps {
+		result["app"] = append(result["app"], keyed{app.ClientName, app})
+	}
+
+	for _, hook := range tenant.Hooks {
+		result["hook"] = append(result["hook"], keyed{hook.URL, hook})
+	}
+
+	for _, group := range tenant.HostedPageGroups {
+		result["hosted_page_group"] = append(result["hosted_page_group"], keyed{group.ID, hostedPageGroup(group)})
+	}
+
+	for _, group := range tenant.TemplateGroups {
+		result["template_group"] = append(result["template_group"], keyed{group.GroupId, group})
+	}
+
+	for _, template := range tenant.Templates {
+		key := strings.Join([]string{template.GroupId, template.TemplateKey, template.TemplateType, template.Locale}, "/")
+		if template.ProcessingType != "" {
+			key += "/" + template.ProcessingType
+		}
+
+		result["template"] = append(result["template"], keyed{key, template})
+	}
+
+	for _, policy := range tenant.PasswordPolicies {
+		result["password_policy"] = append(result["password_policy"], keyed{policy.PolicyName, policy})
+	}
+
+	for _, field := range tenant.RegistrationFields {
+		result["registration_field"] = append(result["registration_field"], keyed{field.FieldKey, field})
+	}
+
+	return result
+}
+
+// hostedPageGroup keys the pages by id and locale so that changes are reported per page
+func hostedPageGroup(group client.HostedPageGroup) interface{} {
+	pages := map[string]client.HostedPage{}
+	for _, page := range group.HostedPages {
+		pages[page.ID+"/"+page.Locale] = page
+	}
+
+	return struct {
+		client.HostedPageGroup
+		HostedPages map[string]client.HostedPage `json:"hosted_pages"`
+	}{group, pages}
+}
+
+func compareKind(kind string, left []keyed, right []keyed) ([]Change, error) {
+	l, err := normalizeAll(left)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := normalizeAll(right)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(l)+len(r))
+	for key := range l {
+		keys = append(keys, key)
+	}
+
+	for key := range r {
+		if _, ok := l[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	var changes []Change
+
+	for _, key := range keys {
+		lv, inLeft := l[key]
+		rv, inRight := r[key]
+
+		switch {
+		case !inLeft:
+			changes = append(changes, Change{Kind: kind, Key: key, Action: ActionAdded})
+		case !inRight:
+			changes = append(changes, Change{Kind: kind, Key: key, Action: ActionRemoved})
+		default:
+			if fields := compareValues("", lv, rv); len(fields) > 0 {
+				changes = append(changes, Change{Kind: kind, Key: key, Action: ActionChanged, Fields: fields})
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// normalizeAll converts the resources to plain JSON values without ignored fields and with redacted secrets. Resources sharing a key, like
+// several hooks calling the same url, are numbered in the order cidaas returns them.
+func normalizeAll(resources []keyed) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+
+	for _, resource := range resources {
+		value, err := normalize(resource.value)
+		if err != nil {
+			return nil, err
+		}
+
+		key := resource.key
+		for i := 2; result[key] != nil; i++ {
+			key = fmt.Sprintf("%s (%d)", resource.key, i)
+		}
+
+		result[key] = value
+	}
+
+	return result, nil
+}
+
+func normalize(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	if object, ok := value.(map[string]interface{}); ok {
+		for key := range object {
+			if ignoredFields[key] {
+				delete(object, key)
+			}
+		}
+	}
+
+	return redact(value), nil
+}
+
+// redact replaces the values of sensitiveFields, set secrets still show up as a change if the other side has none
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if sensitiveFields[key] {
+				if !isEmpty(field) {
+					v[key] = redacted
+				}
+			} else {
+				v[key] = redact(field)
+			}
+		}
+	case []interface{}:
+		for i, element := range v {
+			v[i] = redact(element)
+		}
+	}
+
+	return value
+}
+
+// compareValues descends into objects, lists are compared as a whole since their elements have no stable identity
+func compareValues(path string, left interface{}, right interface{}) []FieldChange {
+	l, leftIsObject := left.(map[string]interface{})
+	r, rightIsObject := right.(map[string]interface{})
+
+	if !leftIsObject || !rightIsObject {
+		if isEmpty(left) && isEmpty(right) || reflect.DeepEqual(left, right) {
+			return nil
+		}
+
+		return []FieldChange{{Path: path, Left: left, Right: right}}
+	}
+
+	keys := make([]string, 0, len(l)+len(r))
+	for key := range l {
+		keys = append(keys, key)
+	}
+
+	for key := range r {
+		if _, ok := l[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	var changes []FieldChange
+
+	for _, key := range keys {
+		fieldPath := key
+		if path != "" {
+			fieldPath = path + "." + key
+		}
+
+		changes = append(changes, compareValues(fieldPath, l[key], r[key])...)
+	}
+
+	return changes
+}
+
+// isEmpty treats missing, null and empty values alike, cidaas omits them inconsistently
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+
+	return false
+}
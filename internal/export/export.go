@@ -0,0 +1,527 @@
+// Package export generates Terraform configuration for the resources of an existing cidaas tenant.
+package export
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/real-digital/terraform-provider-cidaas/internal/client"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Run executes `terraform-provider-cidaas export`
+func Run(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+
+	out := flags.String("out", "", "directory the configuration is written to")
+	profile := flags.String("profile", os.Getenv("CIDAAS_PROFILE"), "profile of the credentials file, defaults to the CIDAAS_* env vars")
+	credentialsFile := flags.String("credentials-file", "", "path of the credentials file, defaults to ~/.cidaas/credentials")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *out == "" {
+		return fmt.Errorf("-out is required")
+	}
+
+	config, err := client.ConfigFromProfile(*profile, *credentialsFile)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.NewClient(config)
+	if err != nil {
+		return fmt.Errorf("could not connect to %s: %w", config.Host, err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	tenant, err := LoadTenant(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	files, err := Write(tenant, *out)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		_, _ = fmt.Fprintln(stdout, file)
+	}
+
+	return nil
+}
+
+type exporter struct {
+	out       string
+	names     names
+	files     map[string]*hclwrite.File
+	variables []string
+	written   []string
+}
+
+// Write writes the configuration of the tenant to dir, one .tf file per resource type with an import block for every
+// resource. Secrets cidaas doesn't return are turned into variables. It returns the written files.
+func Write(tenant *Tenant, dir string) ([]string, error) {
+	e := exporter{
+		out:   dir,
+		names: names{},
+		files: map[string]*hclwrite.File{},
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	for _, app := range tenant.Apps {
+		e.app(app)
+	}
+
+	for _, hook := range tenant.Hooks {
+		e.hook(hook)
+	}
+
+	for _, group := range tenant.HostedPageGroups {
+		if err := e.hostedPageGroup(group); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, group := range tenant.TemplateGroups {
+		e.templateGroup(group)
+	}
+
+	for _, template := range tenant.Templates {
+		if err := e.template(template); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, policy := range tenant.PasswordPolicies {
+		e.passwordPolicy(policy)
+	}
+
+	for _, field := range tenant.RegistrationFields {
+		e.registrationField(field)
+	}
+
+	if len(e.variables) > 0 {
+		file := e.file("variables.tf")
+
+		for _, name := range e.variables {
+			if len(file.Body().Blocks()) > 0 {
+				file.Body().AppendNewline()
+			}
+
+			block := file.Body().AppendNewBlock("variable", []string{name})
+			block.Body().SetAttributeRaw("type", hclwrite.TokensForIdentifier("string"))
+			block.Body().SetAttributeValue("sensitive", cty.True)
+		}
+	}
+
+	fileNames := make([]string, 0, len(e.files))
+	for name := range e.files {
+		fileNames = append(fileNames, name)
+	}
+
+	sort.Strings(fileNames)
+
+	for _, name := range fileNames {
+		if err := e.writeFile(name, hclwrite.Format(e.files[name].Bytes())); err != nil {
+			return nil, err
+		}
+	}
+
+	return e.written, nil
+}
+
+func (e *exporter) file(name string) *hclwrite.File {
+	if e.files[name] == nil {
+		e.files[name] = hclwrite.NewEmptyFile()
+	}
+
+	return e.files[name]
+}
+
+func (e *exporter) writeFile(rel string, content []byte) error {
+	path := filepath.Join(e.out, filepath.FromSlash(rel))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return err
+	}
+
+	e.written = append(e.written, path)
+
+	return nil
+}
+
+func (e *exporter) secret(name string) string {
+	e.variables = append(e.variables, name)
+	return name
+}
+
+func (e *exporter) app(app client.App) {
+	name := e.names.unique("cidaas_app", app.ClientName)
+	body := appendResource(e.file("apps.tf").Body(), "cidaas_app", name, app.ClientId)
+
+	body.SetAttributeValue("client_name", cty.StringVal(app.ClientName))
+	setIfNotEmpty(body, "client_display_name", app.ClientDisplayName)
+	body.SetAttributeValue("client_type", cty.StringVal(app.ClientType))
+	setIfNotEmpty(body, "bot_provider", app.BotProvider)
+	setIfNotEmpty(body, "primary_color", app.PrimaryColor)
+	setIfNotEmpty(body, "accent_color", app.AccentColor)
+	setIfNotEmpty(body, "logo_uri", app.LogoUri)
+	setIfNotEmpty(body, "background_uri", app.BackgroundUri)
+	body.SetAttributeValue("company_name", cty.StringVal(app.CompanyName))
+	body.SetAttributeValue("company_address", cty.StringVal(app.CompanyAddress))
+	body.SetAttributeValue("company_website", cty.StringVal(app.CompanyWebsite))
+
+	body.SetAttributeValue("allowed_scopes", stringList(app.AllowedScopes))
+	body.SetAttributeValue("redirect_uris", stringList(app.RedirectUris))
+	body.SetAttributeValue("allowed_logout_urls", stringList(app.AllowedLogoutUrls))
+	body.SetAttributeValue("response_types", stringList(app.ResponseTypes))
+	body.SetAttributeValue("grant_types", stringList(app.GrantTypes))
+	body.SetAttributeValue("allowed_web_origins", stringList(app.AllowedWebOrigins))
+	body.SetAttributeValue("allowed_origins", stringList(app.AllowedOrigins))
+	body.SetAttributeValue("additional_access_token_payload", stringList(app.AdditionalAccessTokenPayload))
+	if app.EnforcePkce != nil {
+		body.SetAttributeValue("enforce_pkce", cty.BoolVal(*app.EnforcePkce))
+	}
+
+	body.SetAttributeValue("token_lifetime_in_seconds", cty.NumberIntVal(app.TokenLifetimeInSeconds))
+	body.SetAttributeValue("id_token_lifetime_in_seconds", cty.NumberIntVal(app.IdTokenLifetimeInSeconds))
+	body.SetAttributeValue("refresh_token_lifetime_in_seconds", cty.NumberIntVal(app.RefreshTokenLifetimeInSeconds))
+	if app.EnableRefreshTokenRotation != nil {
+		body.SetAttributeValue("enable_refresh_token_rotation", cty.BoolVal(*app.EnableRefreshTokenRotation))
+	}
+
+	if app.RefreshTokenReuseInterval != nil {
+		body.SetAttributeValue("refresh_token_reuse_interval_in_seconds", cty.NumberIntVal(*app.RefreshTokenReuseInterval))
+	}
+
+	body.SetAttributeValue("consent_refs", stringList(app.ConsentRefs))
+	setIfNotEmpty(body, "consent_page_group", app.ConsentPageGroup)
+
+	if app.TemplateGroupId != nil {
+		body.SetAttributeValue("template_group_id", cty.StringVal(*app.TemplateGroupId))
+	}
+
+	customProviders := make([]cty.Value, len(app.CustomProviders))
+	for i, provider := range app.CustomProviders {
+		customProviders[i] = cty.ObjectVal(map[string]cty.Value{
+			"display_name":  cty.StringVal(provider.DisplayName),
+			"provider_name": cty.StringVal(provider.ProviderName),
+		})
+	}
+
+	body.SetAttributeValue("custom_providers", objectList(customProviders))
+
+	if len(app.LoginProviders) > 0 {
+		body.SetAttributeValue("login_providers", stringList(app.LoginProviders))
+	}
+
+	socialProviders := make([]cty.Value, len(app.SocialProviders))
+	for i, provider := range app.SocialProviders {
+		socialProviders[i] = cty.ObjectVal(map[string]cty.Value{
+			"social_id":     cty.StringVal(provider.SocialId),
+			"provider_name": cty.StringVal(provider.ProviderName),
+		})
+	}
+
+	body.SetAttributeValue("social_providers", objectList(socialProviders))
+	body.SetAttributeValue("allow_guest_login", cty.BoolVal(app.AllowGuestLogin))
+
+	if methods := app.SuggestVerificationMethods; methods != nil {
+		body.SetAttributeValue("suggest_verification_methods", cty.ObjectVal(map[string]cty.Value{
+			"mandatory_config":      verificationMethodsConfig(methods.MandatoryConfig),
+			"optional_config":       verificationMethodsConfig(methods.OptionalConfig),
+			"skip_duration_in_days": cty.NumberIntVal(methods.SkipDurationInDays),
+		}))
+	}
+
+	body.SetAttributeValue("allowed_fields", stringList(app.AllowedFields))
+	body.SetAttributeValue("required_fields", stringList(app.RequiredFields))
+	body.SetAttributeValue("communication_medium_verification", cty.StringVal(app.CommunicationMediumVerification))
+
+	if app.PasswordPolicy != nil {
+		body.SetAttributeValue("password_policy", cty.StringVal(*app.PasswordPolicy))
+	}
+
+	body.SetAttributeValue("hosted_page_group", cty.StringVal(app.HostedPageGroup))
+	body.SetAttributeValue("enable_bot_detection", cty.BoolVal(app.EnableBotDetection))
+	body.SetAttributeValue("always_ask_mfa", cty.BoolVal(app.AlwaysAskMfa))
+	body.SetAttributeValue("allowed_mfa", stringList(app.AllowedMfa))
+
+	if app.Mfa != nil {
+		body.SetAttributeValue("mfa", cty.ObjectVal(map[string]cty.Value{
+			"setting":                  cty.StringVal(app.Mfa.Setting),
+			"time_interval_in_seconds": cty.NumberIntVal(app.Mfa.TimeIntervalInSeconds),
+			"allowed_methods":          stringList(app.Mfa.AllowedMethods),
+		}))
+	}
+
+	body.SetAttributeValue("is_remember_me_selected", cty.BoolVal(app.IsRememberMeSelected))
+	body.SetAttributeValue("is_login_success_page_enabled", cty.BoolVal(app.IsLoginSuccessPageEnabled))
+
+	if len(app.AllowedGroups) > 0 {
+		body.SetAttributeValue("allowed_groups", allowedGroups(app.AllowedGroups))
+	}
+
+	body.SetAttributeValue("accept_roles_in_the_registration", cty.BoolVal(app.AcceptRolesInTheRegistration))
+
+	if selection := app.GroupSelection; selection != nil {
+		body.SetAttributeValue("group_selection", cty.ObjectVal(map[string]cty.Value{
+			"always_show_group_selection": cty.BoolVal(selection.AlwaysShowGroupSelection),
+			"selectable_groups":           stringList(selection.SelectableGroups),
+			"selectable_group_types":      stringList(selection.SelectableGroupTypes),
+		}))
+	}
+
+	if len(app.OperationsAllowedGroups) > 0 {
+		body.SetAttributeValue("operations_allowed_groups", allowedGroups(app.OperationsAllowedGroups))
+	}
+
+	body.SetAttributeValue("jwe_enabled", cty.BoolVal(app.JweEnabled))
+	body.SetAttributeValue("auto_login_after_register", cty.BoolVal(app.AutoLoginAfterRegister))
+	body.SetAttributeValue("allow_login_with", stringList(app.AllowLoginWith))
+	body.SetAttributeValue("register_with_login_information", cty.BoolVal(app.RegisterWithLoginInformation))
+	body.SetAttributeValue("fds_enabled", cty.BoolVal(app.FdsEnabled))
+	body.SetAttributeValue("enable_passwordless_auth", cty.BoolVal(app.EnablePasswordlessAuth))
+	body.SetAttributeValue("enable_deduplication", cty.BoolVal(app.EnableDeduplication))
+	body.SetAttributeValue("allow_disposable_email", cty.BoolVal(app.AllowDisposableEmail))
+}
+
+func (e *exporter) hook(hook *client.Hook) {
+	name := e.names.unique("cidaas_hook", hookName(hook))
+	body := appendResource(e.file("hooks.tf").Body(), "cidaas_hook", name, hook.Id)
+
+	body.SetAttributeValue("url", cty.StringVal(hook.URL))
+	body.SetAttributeValue("auth_type", cty.StringVal(hook.AuthType))
+	body.SetAttributeValue("events", stringList(hook.Events))
+
+	switch hook.AuthType {
+	case "APIKEY":
+		body.SetAttributeRaw("apikey_details", hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+			{Name: hclwrite.TokensForIdentifier("apikey"), Value: variable(e.secret(name + "_apikey"))},
+			{Name: hclwrite.TokensForIdentifier("apikey_placeholder"), Value: hclwrite.TokensForValue(cty.StringVal(hook.ApiKeyDetails.APIKeyPlaceholder))},
+			{Name: hclwrite.TokensForIdentifier("apikey_placement"), Value: hclwrite.TokensForValue(cty.StringVal(hook.ApiKeyDetails.APIKeyPlacement))},
+		}))
+	case "TOTP":
+		body.SetAttributeRaw("totp_details", hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+			{Name: hclwrite.TokensForIdentifier("totp_key"), Value: variable(e.secret(name + "_totp_key"))},
+			{Name: hclwrite.TokensForIdentifier("totp_placeholder"), Value: hclwrite.TokensForValue(cty.StringVal(hook.TotpDetails.TotpPlaceholder))},
+			{Name: hclwrite.TokensForIdentifier("totp_placement"), Value: hclwrite.TokensForValue(cty.StringVal(hook.TotpDetails.TotpPlacement))},
+		}))
+	case "CIDAAS_OAUTH2":
+		body.SetAttributeValue("cidaas_auth_details", cty.ObjectVal(map[string]cty.Value{
+			"client_id": cty.StringVal(hook.CidaasAuthDetails.ClientId),
+			"scopes":    stringList(hook.CidaasAuthDetails.Scopes),
+		}))
+	}
+}
+
+func (e *exporter) hostedPageGroup(group client.HostedPageGroup) error {
+	name := e.names.unique("cidaas_hpgroup", group.ID)
+	body := appendResource(e.file("hpgroups.tf").Body(), "cidaas_hpgroup", name, group.ID)
+
+	body.SetAttributeValue("id", cty.StringVal(group.ID))
+	body.SetAttributeValue("default_locale", cty.StringVal(group.DefaultLocale))
+	body.SetAttributeValue("group_owner", cty.StringVal(group.GroupOwner))
+
+	pages := make([]hclwrite.ObjectAttrTokens, 0, len(group.HostedPages))
+
+	for _, page := range group.HostedPages {
+		rel := fmt.Sprintf("hosted_pages/%s/%s.%s.html", name, page.ID, page.Locale)
+
+		if err := e.writeFile(rel, []byte(page.Content)); err != nil {
+			return err
+		}
+
+		attributes := []hclwrite.ObjectAttrTokens{
+			{Name: hclwrite.TokensForIdentifier("source_file"), Value: moduleFile(rel)},
+		}
+
+		if page.Url != "" {
+			attributes = append(attributes, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForIdentifier("url"),
+				Value: hclwrite.TokensForValue(cty.StringVal(page.Url)),
+			})
+		}
+
+		pages = append(pages, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForValue(cty.StringVal(page.ID + "/" + page.Locale)),
+			Value: hclwrite.TokensForObject(attributes),
+		})
+	}
+
+	body.SetAttributeRaw("hosted_pages", hclwrite.TokensForObject(pages))
+
+	return nil
+}
+
+func (e *exporter) templateGroup(group client.TemplateGroup) {
+	name := e.names.unique("cidaas_template_group", group.GroupId)
+	body := appendResource(e.file("template_groups.tf").Body(), "cidaas_template_group", name, group.GroupId)
+
+	body.SetAttributeValue("group_id", cty.StringVal(group.GroupId))
+	body.SetAttributeValue("email_sender_config", cty.ObjectVal(map[string]cty.Value{
+		"from_name":  cty.StringVal(group.EmailSenderConfig.FromName),
+		"from_email": cty.StringVal(group.EmailSenderConfig.FromEmail),
+		"provider":   stringList(group.EmailSenderConfig.Provider),
+	}))
+	body.SetAttributeValue("sms_sender_config", cty.ObjectVal(map[string]cty.Value{
+		"from_name": cty.StringVal(group.SmsSenderConfig.FromName),
+		"provider":  stringList(group.SmsSenderConfig.Provider),
+	}))
+	body.SetAttributeValue("ivr_sender_config", cty.ObjectVal(map[string]cty.Value{
+		"provider": stringList(group.IVRSenderConfig.Provider),
+	}))
+	body.SetAttributeValue("push_sender_config", cty.ObjectVal(map[string]cty.Value{
+		"provider": stringList(group.PushSenderConfig.Provider),
+	}))
+}
+
+func (e *exporter) template(template client.Template) error {
+	importId := fmt.Sprintf("%s/%s/%s/%s", template.GroupId, template.TemplateKey, template.TemplateType, template.Locale)
+	if template.ProcessingType != "" {
+		importId += "/" + template.ProcessingType
+	}
+
+	name := e.names.unique(
+		"cidaas_template",
+		fmt.Sprintf("%s_%s_%s_%s", template.GroupId, template.TemplateKey, template.TemplateType, template.Locale),
+	)
+	body := appendResource(e.file("templates.tf").Body(), "cidaas_template", name, importId)
+
+	rel := fmt.Sprintf("templates/%s.txt", name)
+	if err := e.writeFile(rel, []byte(template.Content)); err != nil {
+		return err
+	}
+
+	body.SetAttributeValue("group_id", cty.StringVal(template.GroupId))
+	body.SetAttributeValue("template_key", cty.StringVal(template.TemplateKey))
+	body.SetAttributeValue("template_type", cty.StringVal(template.TemplateType))
+	setIfNotEmpty(body, "processing_type", template.ProcessingType)
+	body.SetAttributeValue("locale", cty.StringVal(template.Locale))
+	setIfNotEmpty(body, "usage_type", template.UsageType)
+	body.SetAttributeValue("language", cty.StringVal(template.Language))
+	setIfNotEmpty(body, "subject", template.Subject)
+	body.SetAttributeRaw("content", hclwrite.TokensForFunctionCall("file", moduleFile(rel)))
+
+	return nil
+}
+
+func (e *exporter) passwordPolicy(policy client.PasswordPolicy) {
+	name := e.names.unique("cidaas_password_policy", policy.PolicyName)
+	body := appendResource(e.file("password_policies.tf").Body(), "cidaas_password_policy", name, policy.ID)
+
+	body.SetAttributeValue("policy_name", cty.StringVal(policy.PolicyName))
+	body.SetAttributeValue("minimum_length", cty.NumberIntVal(policy.MinimumLength))
+	body.SetAttributeValue("no_of_digits", cty.NumberIntVal(policy.NoOfDigits))
+	body.SetAttributeValue("no_of_special_chars", cty.NumberIntVal(policy.NoOfSpecialChars))
+	body.SetAttributeValue("lower_and_upper_case", cty.BoolVal(policy.LowerAndUpperCase))
+
+	// optional settings cidaas doesn't return are left to its defaults
+	if policy.MaximumLength != nil {
+		body.SetAttributeValue("maximum_length", cty.NumberIntVal(*policy.MaximumLength))
+	}
+
+	if policy.ReuseLimit != nil {
+		body.SetAttributeValue("reuse_limit", cty.NumberIntVal(*policy.ReuseLimit))
+	}
+
+	if policy.ExpirationInDays != nil {
+		body.SetAttributeValue("expiration_in_days", cty.NumberIntVal(*policy.ExpirationInDays))
+	}
+
+	if policy.BlockCompromised != nil {
+		body.SetAttributeValue("block_compromised", cty.BoolVal(*policy.BlockCompromised))
+	}
+
+	if policy.DisallowUsername != nil {
+		body.SetAttributeValue("disallow_username", cty.BoolVal(*policy.DisallowUsername))
+	}
+
+	if policy.DisallowEmail != nil {
+		body.SetAttributeValue("disallow_email", cty.BoolVal(*policy.DisallowEmail))
+	}
+
+	if policy.MaxFailedAttempts != nil {
+		body.SetAttributeValue("max_failed_attempts", cty.NumberIntVal(*policy.MaxFailedAttempts))
+	}
+
+	if policy.LockoutDurationInMinutes != nil {
+		body.SetAttributeValue("lockout_duration_in_minutes", cty.NumberIntVal(*policy.LockoutDurationInMinutes))
+	}
+}
+
+func (e *exporter) registrationField(field client.RegistrationField) {
+	name := e.names.unique("cidaas_registration_field", field.FieldKey)
+	body := appendResource(e.file("registration_fields.tf").Body(), "cidaas_registration_field", name, field.FieldKey)
+
+	body.SetAttributeValue("field_key", cty.StringVal(field.FieldKey))
+	body.SetAttributeValue("data_type", cty.StringVal(field.DataType))
+	body.SetAttributeValue("parent_group_id", cty.StringVal(field.ParentGroupID))
+	body.SetAttributeValue("required", cty.BoolVal(field.Required))
+	body.SetAttributeValue("enabled", cty.BoolVal(field.Enabled))
+	body.SetAttributeValue("read_only", cty.BoolVal(field.ReadOnly))
+	body.SetAttributeValue("claimable", cty.BoolVal(field.Claimable))
+	body.SetAttributeValue("order", cty.NumberIntVal(field.Order))
+
+	if len(field.ConsentRefs) > 0 {
+		body.SetAttributeValue("consent_refs", stringList(field.ConsentRefs))
+	}
+}
+
+func hookName(hook *client.Hook) string {
+	name := hook.URL
+
+	if len(hook.Events) > 0 {
+		name = hook.Events[0]
+	}
+
+	return name
+}
+
+func objectList(values []cty.Value) cty.Value {
+	if len(values) == 0 {
+		return cty.EmptyTupleVal
+	}
+
+	return cty.TupleVal(values)
+}
+
+func verificationMethodsConfig(config client.VerificationMethodsConfig) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"methods": stringList(config.Methods),
+		"range":   cty.StringVal(config.Range),
+	})
+}
+
+func allowedGroups(groups []client.AllowedGroup) cty.Value {
+	values := make([]cty.Value, len(groups))
+
+	for i, group := range groups {
+		values[i] = cty.ObjectVal(map[string]cty.Value{
+			"group_id":      cty.StringVal(group.GroupId),
+			"roles":         stringList(group.Roles),
+			"default_roles": stringList(group.DefaultRoles),
+		})
+	}
+
+	return objectList(values)
+}
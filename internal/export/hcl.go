@@ -0,0 +1,101 @@
+package export
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// names hands out unique Terraform resource names per resource type
+type names map[string]map[string]bool
+
+func (n names) unique(resourceType string, raw string) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(raw), "_"), "_")
+
+	if name == "" {
+		name = "unnamed"
+	}
+
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+
+	if n[resourceType] == nil {
+		n[resourceType] = map[string]bool{}
+	}
+
+	candidate := name
+	for i := 2; n[resourceType][candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+
+	n[resourceType][candidate] = true
+
+	return candidate
+}
+
+// appendResource writes an import block followed by the resource block and returns the body of the resource
+func appendResource(body *hclwrite.Body, resourceType string, name string, importId string) *hclwrite.Body {
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+
+	importBlock := body.AppendNewBlock("import", nil)
+	importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	})
+	importBlock.Body().SetAttributeValue("id", cty.StringVal(importId))
+
+	body.AppendNewline()
+
+	return body.AppendNewBlock("resource", []string{resourceType, name}).Body()
+}
+
+// moduleFile builds the expression "${path.module}/<rel>"
+func moduleFile(rel string) hclwrite.Tokens {
+	return hclwrite.Tokens{
+		{Type: hclsyntax.TokenOQuote, Bytes: []byte(`"`)},
+		{Type: hclsyntax.TokenTemplateInterp, Bytes: []byte(`${`)},
+		{Type: hclsyntax.TokenIdent, Bytes: []byte(`path`)},
+		{Type: hclsyntax.TokenDot, Bytes: []byte(`.`)},
+		{Type: hclsyntax.TokenIdent, Bytes: []byte(`module`)},
+		{Type: hclsyntax.TokenTemplateSeqEnd, Bytes: []byte(`}`)},
+		{Type: hclsyntax.TokenQuotedLit, Bytes: []byte("/" + rel)},
+		{Type: hclsyntax.TokenCQuote, Bytes: []byte(`"`)},
+	}
+}
+
+func variable(name string) hclwrite.Tokens {
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: name},
+	})
+}
+
+func stringList(values []string) cty.Value {
+	if len(values) == 0 {
+		return cty.ListValEmpty(cty.String)
+	}
+
+	elements := make([]cty.Value, len(values))
+	for i, v := range values {
+		elements[i] = cty.StringVal(v)
+	}
+
+	return cty.ListVal(elements)
+}
+
+// setIfNotEmpty sets optional string attributes, cidaas returns empty strings for settings that aren't set
+func setIfNotEmpty(body *hclwrite.Body, name string, value string) {
+	if value != "" {
+		body.SetAttributeValue(name, cty.StringVal(value))
+	}
+}
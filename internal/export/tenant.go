@@ -0,0 +1,73 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/real-digital/terraform-provider-cidaas/internal/client"
+)
+
+// Tenant is the configuration of a tenant as far as it can be managed with the provider
+type Tenant struct {
+	Apps               []client.App
+	Hooks              []*client.Hook
+	HostedPageGroups   []client.HostedPageGroup
+	TemplateGroups     []client.TemplateGroup
+	Templates          []client.Template
+	PasswordPolicies   []client.PasswordPolicy
+	RegistrationFields []client.RegistrationField
+}
+
+// LoadTenant enumerates everything the provider manages in the tenant
+func LoadTenant(ctx context.Context, c client.Client) (*Tenant, error) {
+	var tenant Tenant
+	var err error
+
+	tenant.Apps, err = c.ListApps()
+	if err != nil {
+		return nil, fmt.Errorf("could not list apps: %w", err)
+	}
+
+	tenant.Hooks, err = c.GetHooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list hooks: %w", err)
+	}
+
+	tenant.HostedPageGroups, err = c.ListHostedPagesGroups()
+	if err != nil {
+		return nil, fmt.Errorf("could not list hosted page groups: %w", err)
+	}
+
+	tenant.TemplateGroups, err = c.ListTemplateGroups()
+	if err != nil {
+		return nil, fmt.Errorf("could not list template groups: %w", err)
+	}
+
+	for _, group := range tenant.TemplateGroups {
+		templates, err := c.ListTemplates(group.GroupId)
+		if err != nil {
+			return nil, fmt.Errorf("could not list templates of group %s: %w", group.GroupId, err)
+		}
+
+		tenant.Templates = append(tenant.Templates, templates...)
+	}
+
+	tenant.PasswordPolicies, err = c.ListPasswordPolicies()
+	if err != nil {
+		return nil, fmt.Errorf("could not list password policies: %w", err)
+	}
+
+	fields, err := c.ListRegistrationFields()
+	if err != nil {
+		return nil, fmt.Errorf("could not list registration fields: %w", err)
+	}
+
+	// cidaas_registration_field only manages custom consent fields, built-in fields can't be created or deleted
+	for _, field := range fields {
+		if !field.Internal && field.FieldType == "CUSTOM" && field.DataType == "CONSENT" {
+			tenant.RegistrationFields = append(tenant.RegistrationFields, field)
+		}
+	}
+
+	return &tenant, nil
+}
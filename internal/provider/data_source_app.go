@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/real-digital/terraform-provider-cidaas/internal/client"
+)
+
+type appDataSource struct {
+	provider *cidaasProvider
+}
+
+var _ datasource.DataSource = (*appDataSource)(nil)
+
+func NewAppDataSource() datasource.DataSource {
+	return &appDataSource{}
+}
+
+func (d *appDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app"
+}
+
+func (d *appDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.provider, resp.Diagnostics = toProvider(req.ProviderData)
+}
+
+func (d *appDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an existing app by its client id or its unique client name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"client_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Client id of the app. Exactly one of `client_id` and `client_name` has to be set",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("client_name")),
+				},
+			},
+			"client_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Unique client name of the app",
+			},
+			"include_client_secret": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set, the client secret of the app is read into `client_secret`",
+			},
+			"client_secret": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Client secret of the app, only populated if `include_client_secret` is set",
+			},
+			"client_display_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"client_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"app_owner": schema.StringAttribute{
+				Computed: true,
+			},
+			"hosted_page_group": schema.StringAttribute{
+				Computed: true,
+			},
+			"template_group_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"password_policy": schema.StringAttribute{
+				Computed: true,
+			},
+			"token_lifetime_in_seconds": schema.Int64Attribute{
+				Computed: true,
+			},
+			"id_token_lifetime_in_seconds": schema.Int64Attribute{
+				Computed: true,
+			},
+			"refresh_token_lifetime_in_seconds": schema.Int64Attribute{
+				Computed: true,
+			},
+			"redirect_uris": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"allowed_logout_urls": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"allowed_scopes": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"grant_types": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"response_types": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"allowed_web_origins": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"allowed_origins": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d appDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	var config AppLookup
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientId := config.ClientId.ValueString()
+
+	if config.ClientId.IsNull() {
+		var err error
+		clientId, err = d.findClientIdByName(config.ClientName.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Could not find app", err.Error())
+			return
+		}
+	}
+
+	app, err := d.provider.client.GetApp(clientId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not fetch app",
+			err.Error(),
+		)
+		return
+	}
+
+	if app == nil {
+		resp.Diagnostics.AddError("Could not fetch app",
+			fmt.Sprintf("app with client id %s does not exist", clientId),
+		)
+		return
+	}
+
+	var appState App
+
+	diags = applyAppToState(ctx, &appState, app)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := AppLookup{
+		ID:                            appState.ID,
+		ClientId:                      appState.ClientId,
+		ClientName:                    appState.ClientName,
+		IncludeClientSecret:           config.IncludeClientSecret,
+		ClientSecret:                  types.StringNull(),
+		ClientDisplayName:             appState.ClientDisplayName,
+		ClientType:                    appState.ClientType,
+		AppOwner:                      appState.AppOwner,
+		HostedPageGroup:               appState.HostedPageGroup,
+		TemplateGroupId:               appState.TemplateGroupId,
+		PasswordPolicy:                appState.PasswordPolicy,
+		TokenLifetimeInSeconds:        appState.TokenLifetimeInSeconds,
+		IdTokenLifetimeInSeconds:      appState.IdTokenLifetimeInSeconds,
+		RefreshTokenLifetimeInSeconds: appState.RefreshTokenLifetimeInSeconds,
+
+		RedirectUris:      appState.RedirectUris,
+		AllowedLogoutUrls: appState.AllowedLogoutUrls,
+		Scopes:            appState.Scopes,
+		GrantTypes:        appState.GrantTypes,
+		ResponseTypes:     appState.ResponseTypes,
+		AllowedWebOrigins: appState.AllowedWebOrigins,
+		AllowedOrigins:    appState.AllowedOrigins,
+	}
+
+	if config.IncludeClientSecret.ValueBool() {
+		state.ClientSecret = appState.ClientSecret
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// findClientIdByName resolves the client id of the single app carrying the given client name.
+func (d appDataSource) findClientIdByName(name string) (string, error) {
+	apps, err := d.provider.client.ListApps()
+
+	if err != nil {
+		return "", err
+	}
+
+	var matches []client.App
+
+	for _, app := range apps {
+		if app.ClientName == name {
+			matches = append(matches, app)
+		}
+	}
+
+	if len(matches) == 0 {
+		return "", fmt.Errorf("no app with client name %s exists", name)
+	}
+
+	if len(matches) > 1 {
+		return "", fmt.Errorf("client name %s is not unique, %d apps are using it", name, len(matches))
+	}
+
+	return matches[0].ClientId, nil
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/real-digital/terraform-provider-cidaas/internal/client"
+)
+
+type appsDataSource struct {
+	provider *cidaasProvider
+}
+
+var _ datasource.DataSource = (*appsDataSource)(nil)
+
+func NewAppsDataSource() datasource.DataSource {
+	return &appsDataSource{}
+}
+
+func (d *appsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apps"
+}
+
+func (d *appsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.provider, resp.Diagnostics = toProvider(req.ProviderData)
+}
+
+func (d *appsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all apps in the tenant, optionally filtered by name, type or owner.",
+		Attributes: map[string]schema.Attribute{
+			"client_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return apps with this client name",
+			},
+			"client_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return apps of this client type",
+			},
+			"app_owner": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return apps owned by this owner",
+			},
+			"apps": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Apps matching the given filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"client_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"client_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"client_display_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"client_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"app_owner": schema.StringAttribute{
+							Computed: true,
+						},
+						"hosted_page_group": schema.StringAttribute{
+							Computed: true,
+						},
+						"template_group_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"password_policy": schema.StringAttribute{
+							Computed: true,
+						},
+						"token_lifetime_in_seconds": schema.Int64Attribute{
+							Computed: true,
+						},
+						"id_token_lifetime_in_seconds": schema.Int64Attribute{
+							Computed: true,
+						},
+						"refresh_token_lifetime_in_seconds": schema.Int64Attribute{
+							Computed: true,
+						},
+						"redirect_uris": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"allowed_scopes": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"grant_types": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d appsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	var state Apps
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ClientName.IsUnknown() || state.ClientType.IsUnknown() || state.AppOwner.IsUnknown() {
+		// matching against an unknown filter would return every app of the tenant, so the read waits for the value
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &datasource.Deferred{Reason: datasource.DeferredReasonDataSourceConfigUnknown}
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unknown filter",
+			"The filters of cidaas_apps depend on values that are not known yet. Use a Terraform version supporting "+
+				"deferred actions or apply the resources they depend on first.",
+		)
+		return
+	}
+
+	apps, err := d.provider.client.ListApps()
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not list apps",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Apps = []AppSummary{}
+
+	for _, app := range apps {
+		if !matchesFilter(state.ClientName, app.ClientName) ||
+			!matchesFilter(state.ClientType, app.ClientType) ||
+			!matchesFilter(state.AppOwner, app.AppOwner) {
+			continue
+		}
+
+		state.Apps = append(state.Apps, appToSummary(app))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// matchesFilter reports whether value satisfies an optional filter attribute. Unset filters match everything, unknown
+// filters are rejected by Read before matching.
+func matchesFilter(filter types.String, value string) bool {
+	if filter.IsNull() {
+		return true
+	}
+
+	return filter.ValueString() == value
+}
+
+func appToSummary(app client.App) AppSummary {
+	return AppSummary{
+		ID:                            types.StringValue(app.ID),
+		ClientId:                      types.StringValue(app.ClientId),
+		ClientName:                    types.StringValue(app.ClientName),
+		ClientDisplayName:             types.StringValue(app.ClientDisplayName),
+		ClientType:                    types.StringValue(app.ClientType),
+		AppOwner:                      types.StringValue(app.AppOwner),
+		HostedPageGroup:               types.StringValue(app.HostedPageGroup),
+		TemplateGroupId:               types.StringPointerValue(app.TemplateGroupId),
+		PasswordPolicy:                types.StringPointerValue(app.PasswordPolicy),
+		TokenLifetimeInSeconds:        types.Int64Value(app.TokenLifetimeInSeconds),
+		IdTokenLifetimeInSeconds:      types.Int64Value(app.IdTokenLifetimeInSeconds),
+		RefreshTokenLifetimeInSeconds: types.Int64Value(app.RefreshTokenLifetimeInSeconds),
+
+		RedirectUris:  app.RedirectUris,
+		AllowedScopes: app.AllowedScopes,
+		GrantTypes:    app.GrantTypes,
+	}
+}
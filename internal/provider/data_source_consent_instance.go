@@ -40,6 +40,10 @@ func (d *consentInstanceDataSource) Configure(_ context.Context, req datasource.
 }
 
 func (c consentInstanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !c.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
 	var name string
 	var state ConsentInstance
 
@@ -41,6 +41,10 @@ func (d *customProviderDataSource) Configure(_ context.Context, req datasource.C
 }
 
 func (d customProviderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
 	var providerName string
 
 	var state CustomProvider
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type hooksDataSource struct {
+	provider *cidaasProvider
+}
+
+var _ datasource.DataSource = (*hooksDataSource)(nil)
+
+func NewHooksDataSource() datasource.DataSource {
+	return &hooksDataSource{}
+}
+
+func (d *hooksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hooks"
+}
+
+func (d *hooksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.provider, resp.Diagnostics = toProvider(req.ProviderData)
+}
+
+func (d *hooksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all webhooks configured in the tenant.",
+		Attributes: map[string]schema.Attribute{
+			"hooks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Webhooks of the tenant",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the hook",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "The URL corresponding to the client's Webhook receiver",
+						},
+						"auth_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Authentication method that is used for the hook",
+						},
+						"events": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Hook events which will trigger the hook",
+						},
+						"created_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the hook was created",
+						},
+						"last_updated": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time of the last update of the hook",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d hooksDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	hooks, err := d.provider.client.GetHooks(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not list hooks",
+			err.Error(),
+		)
+		return
+	}
+
+	state := Hooks{
+		Hooks: []HookSummary{},
+	}
+
+	for _, hook := range hooks {
+		state.Hooks = append(state.Hooks, HookSummary{
+			ID:          types.StringValue(hook.Id),
+			Url:         types.StringValue(hook.URL),
+			AuthType:    types.StringValue(hook.AuthType),
+			Events:      hook.Events,
+			CreatedTime: types.StringValue(hook.CreatedTime),
+			LastUpdate:  types.StringValue(hook.UpdatedTime),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type openIDConfigurationDataSource struct {
+	provider *cidaasProvider
+}
+
+var _ datasource.DataSource = (*openIDConfigurationDataSource)(nil)
+
+func NewOpenIDConfigurationDataSource() datasource.DataSource {
+	return &openIDConfigurationDataSource{}
+}
+
+func (d *openIDConfigurationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_openid_configuration"
+}
+
+func (d *openIDConfigurationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.provider, resp.Diagnostics = toProvider(req.ProviderData)
+}
+
+func (d *openIDConfigurationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "OpenID Connect discovery document of the connected tenant, " +
+			"as published at `/.well-known/openid-configuration`.",
+		Attributes: map[string]schema.Attribute{
+			"issuer": schema.StringAttribute{
+				Computed:    true,
+				Description: "Issuer of the tokens of the tenant",
+			},
+			"authorization_endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Url users are sent to for signing in",
+			},
+			"token_endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Url tokens are requested from",
+			},
+			"userinfo_endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Url returning the claims of the signed in user",
+			},
+			"jwks_uri": schema.StringAttribute{
+				Computed:    true,
+				Description: "Url of the keys tokens are signed with",
+			},
+			"end_session_endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Url users are sent to for signing out",
+			},
+			"introspection_endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Url tokens can be validated with",
+			},
+			"revocation_endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Url tokens can be revoked with",
+			},
+			"scopes_supported": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Scopes the tenant supports",
+			},
+			"grant_types_supported": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Grant types the tenant supports",
+			},
+			"response_types_supported": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Response types the tenant supports",
+			},
+			"id_token_signing_alg_values_supported": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Algorithms id tokens may be signed with",
+			},
+			"token_endpoint_auth_methods_supported": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Methods apps may authenticate with at the token endpoint",
+			},
+			"claims_supported": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Claims the tenant may put into tokens",
+			},
+		},
+	}
+}
+
+func (d openIDConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	configuration, err := d.provider.client.GetOpenIDConfiguration()
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not fetch openid configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	state := OpenIDConfiguration{
+		Issuer:                            types.StringValue(configuration.Issuer),
+		AuthorizationEndpoint:             types.StringValue(configuration.AuthorizationEndpoint),
+		TokenEndpoint:                     types.StringValue(configuration.TokenEndpoint),
+		UserinfoEndpoint:                  types.StringValue(configuration.UserinfoEndpoint),
+		JwksUri:                           types.StringValue(configuration.JwksUri),
+		EndSessionEndpoint:                types.StringValue(configuration.EndSessionEndpoint),
+		IntrospectionEndpoint:             types.StringValue(configuration.IntrospectionEndpoint),
+		RevocationEndpoint:                types.StringValue(configuration.RevocationEndpoint),
+		ScopesSupported:                   configuration.ScopesSupported,
+		GrantTypesSupported:               configuration.GrantTypesSupported,
+		ResponseTypesSupported:            configuration.ResponseTypesSupported,
+		IdTokenSigningAlgValuesSupported:  configuration.IdTokenSigningAlgValuesSupported,
+		TokenEndpointAuthMethodsSupported: configuration.TokenEndpointAuthMethodsSupported,
+		ClaimsSupported:                   configuration.ClaimsSupported,
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
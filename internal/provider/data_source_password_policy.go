@@ -5,7 +5,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type passwordPolicyDataSource struct {
@@ -39,6 +38,30 @@ func (d *passwordPolicyDataSource) Schema(_ context.Context, _ datasource.Schema
 			"no_of_special_chars": schema.Int64Attribute{
 				Computed: true,
 			},
+			"maximum_length": schema.Int64Attribute{
+				Computed: true,
+			},
+			"reuse_limit": schema.Int64Attribute{
+				Computed: true,
+			},
+			"expiration_in_days": schema.Int64Attribute{
+				Computed: true,
+			},
+			"block_compromised": schema.BoolAttribute{
+				Computed: true,
+			},
+			"disallow_username": schema.BoolAttribute{
+				Computed: true,
+			},
+			"disallow_email": schema.BoolAttribute{
+				Computed: true,
+			},
+			"max_failed_attempts": schema.Int64Attribute{
+				Computed: true,
+			},
+			"lockout_duration_in_minutes": schema.Int64Attribute{
+				Computed: true,
+			},
 		},
 	}
 }
@@ -52,6 +75,10 @@ func (d *passwordPolicyDataSource) Configure(_ context.Context, req datasource.C
 }
 
 func (d passwordPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
 	var name string
 	var state PasswordPolicy
 
@@ -66,14 +93,13 @@ func (d passwordPolicyDataSource) Read(ctx context.Context, req datasource.ReadR
 	policy, err := d.provider.client.GetPasswordPolicyByName(name)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Could not fetch social provider",
+		resp.Diagnostics.AddError("Could not fetch password policy",
 			err.Error(),
 		)
 		return
 	}
 
-	state.ID = types.StringValue(policy.ID)
-	state.PolicyName = types.StringValue(policy.PolicyName)
+	applyPasswordPolicyToState(&state, policy)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -48,6 +48,10 @@ func (d *socialProviderDataSource) Configure(_ context.Context, req datasource.C
 }
 
 func (d socialProviderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
 	var providerName string
 	var name *string
 
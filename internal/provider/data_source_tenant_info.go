@@ -49,6 +49,10 @@ func (c *tenantInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 }
 
 func (c tenantInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !c.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
 	var state TenantInfo
 
 	info, err := c.provider.client.GetTenantInfo()
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/real-digital/terraform-provider-cidaas/internal/client"
+)
+
+type webhookEventsDataSource struct {
+	provider *cidaasProvider
+}
+
+var _ datasource.DataSource = (*webhookEventsDataSource)(nil)
+
+func NewWebhookEventsDataSource() datasource.DataSource {
+	return &webhookEventsDataSource{}
+}
+
+func (d *webhookEventsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_events"
+}
+
+func (d *webhookEventsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.provider, resp.Diagnostics = toProvider(req.ProviderData)
+}
+
+func (d *webhookEventsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the event types known to the provider that can trigger a `cidaas_hook`.",
+		Attributes: map[string]schema.Attribute{
+			"events": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Supported webhook events",
+			},
+		},
+	}
+}
+
+func (d webhookEventsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	state := WebhookEvents{
+		Events: client.WebhookEvents,
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
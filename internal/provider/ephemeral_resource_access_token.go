@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/real-digital/terraform-provider-cidaas/internal/client"
+)
+
+type accessTokenEphemeralResource struct {
+	provider *cidaasProvider
+}
+
+var _ ephemeral.EphemeralResource = (*accessTokenEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithConfigure = (*accessTokenEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithValidateConfig = (*accessTokenEphemeralResource)(nil)
+
+const (
+	grantTypeClientCredentials = "client_credentials"
+	grantTypePassword          = "password"
+)
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &accessTokenEphemeralResource{}
+}
+
+func (r *accessTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *accessTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.provider, resp.Diagnostics = toProvider(req.ProviderData)
+}
+
+func (r *accessTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "`cidaas_access_token` requests an access token for an app of the tenant. The token is never " +
+			"persisted to the state or plan.",
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Client id of the app the token is issued for",
+			},
+			"client_secret": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Client secret of the app, required for confidential apps",
+			},
+			"grant_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(grantTypeClientCredentials, grantTypePassword),
+				},
+				Description: "`client_credentials` (default) or `password` to sign in as a user",
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Description: "Username of the user signing in with the `password` grant",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of the user signing in with the `password` grant",
+			},
+			"scopes": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Scopes requested for the token",
+			},
+			"access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The issued access token",
+			},
+			"id_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The issued id token, if the `openid` scope was requested",
+			},
+			"token_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the access token, usually `Bearer`",
+			},
+			"expires_in": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Lifetime of the access token in seconds",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time the access token expires at, RFC 3339 formatted",
+			},
+		},
+	}
+}
+
+func (r *accessTokenEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config AccessToken
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || config.GrantType.ValueString() != grantTypePassword {
+		return
+	}
+
+	if config.Username.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Missing username",
+			"The password grant requires a username",
+		)
+	}
+
+	if config.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing password",
+			"The password grant requires a password",
+		)
+	}
+}
+
+func (r *accessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if !r.provider.hasHost(&resp.Diagnostics) {
+		return
+	}
+
+	var config AccessToken
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.GrantType.IsNull() || config.GrantType.IsUnknown() {
+		config.GrantType = types.StringValue(grantTypeClientCredentials)
+	}
+
+	var scopes []string
+
+	diags = config.Scopes.ElementsAs(ctx, &scopes, false)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := client.RequestToken(r.provider.clientConfig, client.TokenRequest{
+		GrantType:    config.GrantType.ValueString(),
+		ClientId:     config.ClientId.ValueString(),
+		ClientSecret: config.ClientSecret.ValueString(),
+		Username:     config.Username.ValueString(),
+		Password:     config.Password.ValueString(),
+		Scope:        strings.Join(scopes, " "),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not request access token", err.Error())
+		return
+	}
+
+	config.AccessToken = types.StringValue(token.AccessToken)
+	config.IdToken = optionalString(token.IdToken)
+	config.TokenType = types.StringValue(token.TokenType)
+	config.ExpiresIn = types.Int64Value(token.ExpiresIn)
+	config.ExpiresAt = types.StringValue(time.Now().Add(time.Duration(token.ExpiresIn) * time.Second).UTC().Format(time.RFC3339))
+
+	diags = resp.Result.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type authorizeUrlFunction struct{}
+
+var _ function.Function = (*authorizeUrlFunction)(nil)
+
+func NewAuthorizeUrlFunction() function.Function {
+	return &authorizeUrlFunction{}
+}
+
+func (f *authorizeUrlFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "authorize_url"
+}
+
+func (f *authorizeUrlFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Authorization url for the code flow of an app",
+		Description: "Builds the url users are sent to for signing in to an app with the authorization code flow.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "host",
+				Description: "Host of the tenant, e.g. `example.cidaas.de` or `https://example.cidaas.de`",
+			},
+			function.StringParameter{
+				Name:        "client_id",
+				Description: "Client id of the app",
+			},
+			function.StringParameter{
+				Name:        "redirect_uri",
+				Description: "Url cidaas redirects to after signing in, must be one of the `redirect_uris` of the app",
+			},
+			function.ListParameter{
+				Name:        "scopes",
+				ElementType: types.StringType,
+				Description: "Scopes to request",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *authorizeUrlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var host, clientId, redirectUri string
+	var scopes []string
+
+	resp.Error = req.Arguments.Get(ctx, &host, &clientId, &redirectUri, &scopes)
+	if resp.Error != nil {
+		return
+	}
+
+	issuer, err := oidcIssuer(host)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	query := url.Values{}
+	query.Set("client_id", clientId)
+	query.Set("redirect_uri", redirectUri)
+	query.Set("response_type", "code")
+	query.Set("scope", strings.Join(scopes, " "))
+
+	resp.Error = resp.Result.Set(ctx, issuer+"/authz-srv/authz?"+query.Encode())
+}
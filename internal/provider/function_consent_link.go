@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/real-digital/terraform-provider-cidaas/internal/client"
+)
+
+type consentLinkFunction struct{}
+
+var _ function.Function = (*consentLinkFunction)(nil)
+
+func NewConsentLinkFunction() function.Function {
+	return &consentLinkFunction{}
+}
+
+func (f *consentLinkFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "consent_link"
+}
+
+func (f *consentLinkFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Label of a consent registration field",
+		Description: "Returns the html link `cidaas_registration_field` uses as label of a consent, e.g. to render " +
+			"the same link in hosted pages.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ref",
+				Description: "Reference of the consent, the first of the `consent_refs` of the registration field",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *consentLinkFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ref string
+
+	resp.Error = req.Arguments.Get(ctx, &ref)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, client.ConsentLink(ref))
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type decodeJwtClaimsFunction struct{}
+
+var _ function.Function = (*decodeJwtClaimsFunction)(nil)
+
+func NewDecodeJwtClaimsFunction() function.Function {
+	return &decodeJwtClaimsFunction{}
+}
+
+func (f *decodeJwtClaimsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "decode_jwt_claims"
+}
+
+func (f *decodeJwtClaimsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Claims of a JWT",
+		Description: "Decodes the payload of a JWT, e.g. an access token or id token issued by cidaas, into an object. " +
+			"The signature is not verified.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "token",
+				Description: "The encoded JWT",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f *decodeJwtClaimsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var token string
+
+	resp.Error = req.Arguments.Get(ctx, &token)
+	if resp.Error != nil {
+		return
+	}
+
+	claims, err := decodeJwtClaims(token)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, types.DynamicValue(claims))
+}
+
+func decodeJwtClaims(token string) (attr.Value, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("a JWT consists of 3 parts separated by dots, got %d", len(parts))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode the payload: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	var claims map[string]interface{}
+	if err := decoder.Decode(&claims); err != nil {
+		return nil, fmt.Errorf("the payload is not a JSON object: %w", err)
+	}
+
+	return jsonToValue(claims)
+}
+
+// jsonToValue converts decoded JSON to a framework value, objects become objects and arrays tuples, as claims
+// don't have a fixed type
+func jsonToValue(value interface{}) (attr.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case string:
+		return types.StringValue(v), nil
+	case json.Number:
+		number, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NumberValue(number), nil
+	case []interface{}:
+		elementTypes := make([]attr.Type, len(v))
+		elements := make([]attr.Value, len(v))
+
+		for i, element := range v {
+			converted, err := jsonToValue(element)
+			if err != nil {
+				return nil, err
+			}
+
+			elementTypes[i] = converted.Type(context.Background())
+			elements[i] = converted
+		}
+
+		tuple, diags := types.TupleValue(elementTypes, elements)
+		if diags.HasError() {
+			return nil, fmt.Errorf("%s", diags[0].Detail())
+		}
+
+		return tuple, nil
+	case map[string]interface{}:
+		attributeTypes := make(map[string]attr.Type, len(v))
+		attributes := make(map[string]attr.Value, len(v))
+
+		for key, attribute := range v {
+			converted, err := jsonToValue(attribute)
+			if err != nil {
+				return nil, err
+			}
+
+			attributeTypes[key] = converted.Type(context.Background())
+			attributes[key] = converted
+		}
+
+		object, diags := types.ObjectValue(attributeTypes, attributes)
+		if diags.HasError() {
+			return nil, fmt.Errorf("%s", diags[0].Detail())
+		}
+
+		return object, nil
+	}
+
+	return nil, fmt.Errorf("unsupported JSON value %v", value)
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type oidcIssuerFunction struct{}
+
+var _ function.Function = (*oidcIssuerFunction)(nil)
+
+func NewOidcIssuerFunction() function.Function {
+	return &oidcIssuerFunction{}
+}
+
+func (f *oidcIssuerFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "oidc_issuer"
+}
+
+func (f *oidcIssuerFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Issuer of the tokens of a cidaas tenant",
+		Description: "Returns the issuer of the tokens of a cidaas tenant, which is also the base url of its openid " +
+			"configuration. The host may be given with or without scheme.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "host",
+				Description: "Host of the tenant, e.g. `example.cidaas.de` or `https://example.cidaas.de`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *oidcIssuerFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var host string
+
+	resp.Error = req.Arguments.Get(ctx, &host)
+	if resp.Error != nil {
+		return
+	}
+
+	issuer, err := oidcIssuer(host)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, issuer)
+}
+
+// oidcIssuer normalizes the host of a tenant to the issuer cidaas puts into its tokens
+func oidcIssuer(host string) (string, error) {
+	host = strings.TrimSpace(host)
+
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return "", err
+	}
+
+	if u.Host == "" {
+		return "", fmt.Errorf("%q is not a valid host", host)
+	}
+
+	return u.Scheme + "://" + u.Host + strings.TrimRight(u.Path, "/"), nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// limitResults streams results, stopping after the number of results Terraform asked for
+func limitResults(limit int64, results []list.ListResult) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, result := range results {
+			if limit > 0 && int64(i) >= limit {
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// importID returns the id of an import block, which Terraform 1.12 and later can also pass as resource identity
+func importID(ctx context.Context, req resource.ImportStateRequest, identityAttribute string, diags *diag.Diagnostics) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+
+	var id types.String
+
+	diags.Append(req.Identity.GetAttribute(ctx, path.Root(identityAttribute), &id)...)
+
+	return id.ValueString()
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = (*appResource)(nil)
+
+func NewAppListResource() list.ListResource {
+	return &appResource{}
+}
+
+func (r *appResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all apps of the tenant",
+	}
+}
+
+func (r appResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+
+	if !r.provider.isConfigured(&diags) {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	apps, err := r.provider.client.ListApps()
+	if err != nil {
+		diags.AddError("Could not list apps", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results := make([]list.ListResult, 0, len(apps))
+
+	for i := range apps {
+		result := req.NewListResult(ctx)
+		result.DisplayName = apps[i].ClientName
+
+		diags = result.Identity.Set(ctx, AppIdentity{ClientId: types.StringValue(apps[i].ClientId)})
+		result.Diagnostics.Append(diags...)
+
+		if req.IncludeResource {
+			// the list only contains a summary of each app, e.g. without its keys
+			app, err := r.provider.client.GetApp(apps[i].ClientId)
+			if err != nil {
+				result.Diagnostics.AddError("Could not read app "+apps[i].ClientId, err.Error())
+			} else {
+				state := newAppState()
+				result.Diagnostics.Append(applyAppToState(ctx, &state, app)...)
+
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+				}
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	stream.Results = limitResults(req.Limit, results)
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+var _ list.ListResourceWithConfigure = (*hookResource)(nil)
+
+func NewHookListResource() list.ListResource {
+	return &hookResource{}
+}
+
+func (r *hookResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all webhooks of the tenant. cidaas doesn't return api keys and totp keys, they have to be " +
+			"added to the generated configuration.",
+	}
+}
+
+func (r hookResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+
+	if !r.provider.isConfigured(&diags) {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	hooks, err := r.provider.client.GetHooks(ctx)
+	if err != nil {
+		diags.AddError("Could not list hooks", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results := make([]list.ListResult, 0, len(hooks))
+
+	for _, hook := range hooks {
+		var state Hook
+
+		applyHookToState(&state, hook)
+
+		result := req.NewListResult(ctx)
+		result.DisplayName = hook.URL
+
+		diags = result.Identity.Set(ctx, HookIdentity{ID: state.ID})
+		result.Diagnostics.Append(diags...)
+
+		if req.IncludeResource {
+			diags = result.Resource.Set(ctx, &state)
+			result.Diagnostics.Append(diags...)
+		}
+
+		results = append(results, result)
+	}
+
+	stream.Results = limitResults(req.Limit, results)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = (*hostedPageGroupResource)(nil)
+
+func NewHostedPageGroupListResource() list.ListResource {
+	return &hostedPageGroupResource{}
+}
+
+func (r *hostedPageGroupResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all hosted page groups of the tenant",
+	}
+}
+
+func (r hostedPageGroupResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+
+	if !r.provider.isConfigured(&diags) {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	groups, err := r.provider.client.ListHostedPagesGroups()
+	if err != nil {
+		diags.AddError("Could not list hosted page groups", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results := make([]list.ListResult, 0, len(groups))
+
+	for _, group := range groups {
+		result := req.NewListResult(ctx)
+		result.DisplayName = group.ID
+
+		diags = result.Identity.Set(ctx, HostedPageGroupIdentity{ID: types.StringValue(group.ID)})
+		result.Diagnostics.Append(diags...)
+
+		if req.IncludeResource {
+			state := HostedPageGroup{
+				ID:            types.StringValue(group.ID),
+				GroupOwner:    types.StringValue(group.GroupOwner),
+				CreatedTime:   types.StringValue(group.CreatedTime),
+				UpdatedTime:   types.StringValue(group.UpdatedTime),
+				DefaultLocale: types.StringValue(group.DefaultLocale),
+			}
+
+			state.HostedPages, diags = hostedPagesToState(ctx, group.HostedPages, nil)
+			result.Diagnostics.Append(diags...)
+
+			if !result.Diagnostics.HasError() {
+				result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	stream.Results = limitResults(req.Limit, results)
+}
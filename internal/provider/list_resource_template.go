@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/real-digital/terraform-provider-cidaas/internal/client"
+)
+
+var _ list.ListResourceWithConfigure = (*templateResource)(nil)
+
+type TemplateListConfig struct {
+	GroupId types.String `tfsdk:"group_id"`
+}
+
+func NewTemplateListResource() list.ListResource {
+	return &templateResource{}
+}
+
+func (r *templateResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the templates of the tenant",
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the templates of this template group, defaults to all groups",
+			},
+		},
+	}
+}
+
+func (r templateResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	var config TemplateListConfig
+
+	if !r.provider.isConfigured(&diags) {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	groupIds := []string{config.GroupId.ValueString()}
+
+	if config.GroupId.IsNull() {
+		groups, err := r.provider.client.ListTemplateGroups()
+		if err != nil {
+			diags.AddError("Could not list template groups", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		groupIds = make([]string, len(groups))
+		for i, group := range groups {
+			groupIds[i] = group.GroupId
+		}
+	}
+
+	var templates []client.Template
+
+	for _, groupId := range groupIds {
+		groupTemplates, err := r.provider.client.ListTemplates(groupId)
+		if err != nil {
+			diags.AddError("Could not list templates of group "+groupId, err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		templates = append(templates, groupTemplates...)
+	}
+
+	results := make([]list.ListResult, 0, len(templates))
+
+	for _, template := range templates {
+		state := Template{
+			ID:             types.StringPointerValue(template.ID),
+			LastSeededBy:   types.StringPointerValue(template.LastSeededBy),
+			GroupId:        types.StringValue(template.GroupId),
+			TemplateKey:    types.StringValue(template.TemplateKey),
+			TemplateType:   types.StringValue(template.TemplateType),
+			ProcessingType: optionalString(template.ProcessingType),
+			Locale:         types.StringValue(template.Locale),
+			Language:       types.StringValue(template.Language),
+			UsageType:      optionalString(template.UsageType),
+			Subject:        optionalString(template.Subject),
+			Content:        types.StringValue(template.Content),
+		}
+
+		result := req.NewListResult(ctx)
+		result.DisplayName = strings.Join(
+			[]string{template.GroupId, template.TemplateKey, template.TemplateType, template.Locale},
+			"/",
+		)
+
+		diags = result.Identity.Set(ctx, templateIdentity(&state))
+		result.Diagnostics.Append(diags...)
+
+		if req.IncludeResource {
+			diags = result.Resource.Set(ctx, &state)
+			result.Diagnostics.Append(diags...)
+		}
+
+		results = append(results, result)
+	}
+
+	stream.Results = limitResults(req.Limit, results)
+}
+
+// optionalString maps the empty strings cidaas returns for unset settings to null
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
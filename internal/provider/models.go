@@ -9,13 +9,32 @@ type TenantInfo struct {
 	VersionInfo        types.String `tfsdk:"version_info"`
 }
 
+type OpenIDConfiguration struct {
+	Issuer                            types.String `tfsdk:"issuer"`
+	AuthorizationEndpoint             types.String `tfsdk:"authorization_endpoint"`
+	TokenEndpoint                     types.String `tfsdk:"token_endpoint"`
+	UserinfoEndpoint                  types.String `tfsdk:"userinfo_endpoint"`
+	JwksUri                           types.String `tfsdk:"jwks_uri"`
+	EndSessionEndpoint                types.String `tfsdk:"end_session_endpoint"`
+	IntrospectionEndpoint             types.String `tfsdk:"introspection_endpoint"`
+	RevocationEndpoint                types.String `tfsdk:"revocation_endpoint"`
+	ScopesSupported                   []string     `tfsdk:"scopes_supported"`
+	GrantTypesSupported               []string     `tfsdk:"grant_types_supported"`
+	ResponseTypesSupported            []string     `tfsdk:"response_types_supported"`
+	IdTokenSigningAlgValuesSupported  []string     `tfsdk:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string     `tfsdk:"token_endpoint_auth_methods_supported"`
+	ClaimsSupported                   []string     `tfsdk:"claims_supported"`
+}
+
 type Hook struct {
-	ID            types.String      `tfsdk:"id"`
-	LastUpdate    types.String      `tfsdk:"last_updated"`
-	Url           string            `tfsdk:"url"`
-	AuthType      string            `tfsdk:"auth_type"`
-	Events        []string          `tfsdk:"events"`
-	APIKeyDetails HookAPIKeyDetails `tfsdk:"apikey_details"`
+	ID                types.String           `tfsdk:"id"`
+	LastUpdate        types.String           `tfsdk:"last_updated"`
+	Url               string                 `tfsdk:"url"`
+	AuthType          string                 `tfsdk:"auth_type"`
+	Events            []string               `tfsdk:"events"`
+	APIKeyDetails     *HookAPIKeyDetails     `tfsdk:"apikey_details"`
+	TotpDetails       *HookTotpDetails       `tfsdk:"totp_details"`
+	CidaasAuthDetails *HookCidaasAuthDetails `tfsdk:"cidaas_auth_details"`
 }
 
 type HookAPIKeyDetails struct {
@@ -24,6 +43,34 @@ type HookAPIKeyDetails struct {
 	APIKeyPlacement   string `tfsdk:"apikey_placement"`
 }
 
+type HookTotpDetails struct {
+	TotpKey         string `tfsdk:"totp_key"`
+	TotpPlaceholder string `tfsdk:"totp_placeholder"`
+	TotpPlacement   string `tfsdk:"totp_placement"`
+}
+
+type HookCidaasAuthDetails struct {
+	ClientId string   `tfsdk:"client_id"`
+	Scopes   []string `tfsdk:"scopes"`
+}
+
+type Hooks struct {
+	Hooks []HookSummary `tfsdk:"hooks"`
+}
+
+type HookSummary struct {
+	ID          types.String `tfsdk:"id"`
+	Url         types.String `tfsdk:"url"`
+	AuthType    types.String `tfsdk:"auth_type"`
+	Events      []string     `tfsdk:"events"`
+	CreatedTime types.String `tfsdk:"created_time"`
+	LastUpdate  types.String `tfsdk:"last_updated"`
+}
+
+type WebhookEvents struct {
+	Events []string `tfsdk:"events"`
+}
+
 type SocialProvider struct {
 	SocialId     types.String `tfsdk:"social_id"`
 	ProviderName types.String `tfsdk:"provider_name"`
@@ -42,12 +89,20 @@ type ConsentInstance struct {
 }
 
 type PasswordPolicy struct {
-	ID                types.String `tfsdk:"id"`
-	PolicyName        types.String `tfsdk:"policy_name"`
-	MinimumLength     types.Int64  `tfsdk:"minimum_length"`
-	NoOfDigits        types.Int64  `tfsdk:"no_of_digits"`
-	LowerAndUpperCase types.Bool   `tfsdk:"lower_and_upper_case"`
-	NoOfSpecialChars  types.Int64  `tfsdk:"no_of_special_chars"`
+	ID                       types.String `tfsdk:"id"`
+	PolicyName               types.String `tfsdk:"policy_name"`
+	MinimumLength            types.Int64  `tfsdk:"minimum_length"`
+	MaximumLength            types.Int64  `tfsdk:"maximum_length"`
+	NoOfDigits               types.Int64  `tfsdk:"no_of_digits"`
+	LowerAndUpperCase        types.Bool   `tfsdk:"lower_and_upper_case"`
+	NoOfSpecialChars         types.Int64  `tfsdk:"no_of_special_chars"`
+	ReuseLimit               types.Int64  `tfsdk:"reuse_limit"`
+	ExpirationInDays         types.Int64  `tfsdk:"expiration_in_days"`
+	BlockCompromised         types.Bool   `tfsdk:"block_compromised"`
+	DisallowUsername         types.Bool   `tfsdk:"disallow_username"`
+	DisallowEmail            types.Bool   `tfsdk:"disallow_email"`
+	MaxFailedAttempts        types.Int64  `tfsdk:"max_failed_attempts"`
+	LockoutDurationInMinutes types.Int64  `tfsdk:"lockout_duration_in_minutes"`
 }
 
 type HostedPageGroup struct {
@@ -56,7 +111,15 @@ type HostedPageGroup struct {
 	UpdatedTime   types.String `tfsdk:"updated_time"`
 	DefaultLocale types.String `tfsdk:"default_locale"`
 	GroupOwner    types.String `tfsdk:"group_owner"`
-	HostedPages   types.List   `tfsdk:"hosted_pages"`
+	HostedPages   types.Map    `tfsdk:"hosted_pages"`
+}
+
+type HostedPage struct {
+	Url          types.String `tfsdk:"url"`
+	Content      types.String `tfsdk:"content"`
+	SourceFile   types.String `tfsdk:"source_file"`
+	TemplateVars types.Map    `tfsdk:"template_vars"`
+	ContentHash  types.String `tfsdk:"content_hash"`
 }
 
 type App struct {
@@ -94,6 +157,16 @@ type App struct {
 	RegisterWithLoginInformation    types.Bool   `tfsdk:"register_with_login_information"`
 	AppKey                          types.Object `tfsdk:"app_key"`
 	TemplateGroupId                 types.String `tfsdk:"template_group_id"`
+	EnforcePkce                     types.Bool   `tfsdk:"enforce_pkce"`
+	EnableRefreshTokenRotation      types.Bool   `tfsdk:"enable_refresh_token_rotation"`
+	RefreshTokenReuseInterval       types.Int64  `tfsdk:"refresh_token_reuse_interval_in_seconds"`
+	LogoUri                         types.String `tfsdk:"logo_uri"`
+	BackgroundUri                   types.String `tfsdk:"background_uri"`
+	ConsentPageGroup                types.String `tfsdk:"consent_page_group"`
+	Mfa                             types.Object `tfsdk:"mfa"`
+	GroupSelection                  types.Object `tfsdk:"group_selection"`
+	SuggestVerificationMethods      types.Object `tfsdk:"suggest_verification_methods"`
+	LoginProviders                  types.List   `tfsdk:"login_providers"`
 
 	AllowedGroups                types.List       `tfsdk:"allowed_groups"`
 	OperationsAllowedGroups      types.List       `tfsdk:"operations_allowed_groups"`
@@ -114,6 +187,57 @@ type App struct {
 	AllowedMfa                   []string         `tfsdk:"allowed_mfa"`
 }
 
+type Apps struct {
+	ClientName types.String `tfsdk:"client_name"`
+	ClientType types.String `tfsdk:"client_type"`
+	AppOwner   types.String `tfsdk:"app_owner"`
+	Apps       []AppSummary `tfsdk:"apps"`
+}
+
+type AppLookup struct {
+	ID                            types.String `tfsdk:"id"`
+	ClientId                      types.String `tfsdk:"client_id"`
+	ClientName                    types.String `tfsdk:"client_name"`
+	IncludeClientSecret           types.Bool   `tfsdk:"include_client_secret"`
+	ClientSecret                  types.String `tfsdk:"client_secret"`
+	ClientDisplayName             types.String `tfsdk:"client_display_name"`
+	ClientType                    types.String `tfsdk:"client_type"`
+	AppOwner                      types.String `tfsdk:"app_owner"`
+	HostedPageGroup               types.String `tfsdk:"hosted_page_group"`
+	TemplateGroupId               types.String `tfsdk:"template_group_id"`
+	PasswordPolicy                types.String `tfsdk:"password_policy"`
+	TokenLifetimeInSeconds        types.Int64  `tfsdk:"token_lifetime_in_seconds"`
+	IdTokenLifetimeInSeconds      types.Int64  `tfsdk:"id_token_lifetime_in_seconds"`
+	RefreshTokenLifetimeInSeconds types.Int64  `tfsdk:"refresh_token_lifetime_in_seconds"`
+
+	RedirectUris      []string `tfsdk:"redirect_uris"`
+	AllowedLogoutUrls []string `tfsdk:"allowed_logout_urls"`
+	Scopes            []string `tfsdk:"allowed_scopes"`
+	GrantTypes        []string `tfsdk:"grant_types"`
+	ResponseTypes     []string `tfsdk:"response_types"`
+	AllowedWebOrigins []string `tfsdk:"allowed_web_origins"`
+	AllowedOrigins    []string `tfsdk:"allowed_origins"`
+}
+
+type AppSummary struct {
+	ID                            types.String `tfsdk:"id"`
+	ClientId                      types.String `tfsdk:"client_id"`
+	ClientName                    types.String `tfsdk:"client_name"`
+	ClientDisplayName             types.String `tfsdk:"client_display_name"`
+	ClientType                    types.String `tfsdk:"client_type"`
+	AppOwner                      types.String `tfsdk:"app_owner"`
+	HostedPageGroup               types.String `tfsdk:"hosted_page_group"`
+	TemplateGroupId               types.String `tfsdk:"template_group_id"`
+	PasswordPolicy                types.String `tfsdk:"password_policy"`
+	TokenLifetimeInSeconds        types.Int64  `tfsdk:"token_lifetime_in_seconds"`
+	IdTokenLifetimeInSeconds      types.Int64  `tfsdk:"id_token_lifetime_in_seconds"`
+	RefreshTokenLifetimeInSeconds types.Int64  `tfsdk:"refresh_token_lifetime_in_seconds"`
+
+	RedirectUris  []string `tfsdk:"redirect_uris"`
+	AllowedScopes []string `tfsdk:"allowed_scopes"`
+	GrantTypes    []string `tfsdk:"grant_types"`
+}
+
 type RegistrationField struct {
 	ID            types.String `tfsdk:"id"`
 	Required      types.Bool   `tfsdk:"required"`
@@ -149,3 +273,69 @@ type Template struct {
 	Subject        types.String `tfsdk:"subject"`
 	Content        types.String `tfsdk:"content"`
 }
+
+type AppIdentity struct {
+	ClientId types.String `tfsdk:"client_id"`
+}
+
+type HookIdentity struct {
+	ID types.String `tfsdk:"id"`
+}
+
+type HostedPageGroupIdentity struct {
+	ID types.String `tfsdk:"id"`
+}
+
+type TemplateIdentity struct {
+	GroupId        types.String `tfsdk:"group_id"`
+	TemplateKey    types.String `tfsdk:"template_key"`
+	TemplateType   types.String `tfsdk:"template_type"`
+	Locale         types.String `tfsdk:"locale"`
+	ProcessingType types.String `tfsdk:"processing_type"`
+}
+
+type AccessToken struct {
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	GrantType    types.String `tfsdk:"grant_type"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	Scopes       types.List   `tfsdk:"scopes"`
+	AccessToken  types.String `tfsdk:"access_token"`
+	IdToken      types.String `tfsdk:"id_token"`
+	TokenType    types.String `tfsdk:"token_type"`
+	ExpiresIn    types.Int64  `tfsdk:"expires_in"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+}
+
+type User struct {
+	ID                   types.String      `tfsdk:"id"`
+	Email                types.String      `tfsdk:"email"`
+	EmailVerified        types.Bool        `tfsdk:"email_verified"`
+	MobileNumber         types.String      `tfsdk:"mobile_number"`
+	MobileNumberVerified types.Bool        `tfsdk:"mobile_number_verified"`
+	Username             types.String      `tfsdk:"username"`
+	Password             types.String      `tfsdk:"password"`
+	GivenName            types.String      `tfsdk:"given_name"`
+	FamilyName           types.String      `tfsdk:"family_name"`
+	Roles                types.Set         `tfsdk:"roles"`
+	Groups               []UserGroup       `tfsdk:"groups"`
+	CustomFields         map[string]string `tfsdk:"custom_fields"`
+}
+
+type UserGroup struct {
+	GroupId types.String `tfsdk:"group_id"`
+	Roles   []string     `tfsdk:"roles"`
+}
+
+type UserGroupMembership struct {
+	ID      types.String  `tfsdk:"id"`
+	GroupId types.String  `tfsdk:"group_id"`
+	Mode    types.String  `tfsdk:"mode"`
+	Members []GroupMember `tfsdk:"members"`
+}
+
+type GroupMember struct {
+	Sub   types.String `tfsdk:"sub"`
+	Roles []string     `tfsdk:"roles"`
+}
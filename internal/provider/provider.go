@@ -3,11 +3,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"os"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,6 +25,9 @@ import (
 )
 
 var _ provider.Provider = (*cidaasProvider)(nil)
+var _ provider.ProviderWithListResources = (*cidaasProvider)(nil)
+var _ provider.ProviderWithEphemeralResources = (*cidaasProvider)(nil)
+var _ provider.ProviderWithFunctions = (*cidaasProvider)(nil)
 
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -29,6 +41,13 @@ type cidaasProvider struct {
 	configured bool
 	client     client.Client
 	version    string
+
+	// clientConfig is kept for requests that don't use the credentials of the provider, like those of cidaas_access_token
+	clientConfig client.Config
+
+	protectedTenantKeys []string
+	tenantInfo          *client.TenantInfo
+	tenantInfoMutex     sync.Mutex
 }
 
 func (p *cidaasProvider) Metadata(_ context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -41,6 +60,14 @@ func (p *cidaasProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 			"host": schema.StringAttribute{
 				Optional: true,
 			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of a profile in the credentials file to read the host and credentials from. Attributes set in the provider block take precedence over the profile. Can also be set with the CIDAAS_PROFILE env var",
+			},
+			"credentials_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the credentials file containing the profiles, defaults to `~/.cidaas/credentials`. Can also be set with the CIDAAS_CREDENTIALS_FILE env var",
+			},
 			"client_id": schema.StringAttribute{
 				Optional: true,
 			},
@@ -48,14 +75,127 @@ func (p *cidaasProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Optional:  true,
 				Sensitive: true,
 			},
+			"access_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Pre-issued access token used instead of signing in with client_id and client_secret. Can also be set with the CIDAAS_ACCESS_TOKEN env var",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("access_token_file")),
+				},
+			},
+			"access_token_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file containing a pre-issued access token. The file is read again once the token expired. Can also be set with the CIDAAS_ACCESS_TOKEN_FILE env var",
+			},
+			"client_private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key used for `private_key_jwt` client authentication instead of client_secret. Can also be set with the CIDAAS_CLIENT_PRIVATE_KEY env var",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_private_key_file"), path.MatchRoot("client_secret")),
+				},
+			},
+			"client_private_key_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM encoded private key used for `private_key_jwt` client authentication. Can also be set with the CIDAAS_CLIENT_PRIVATE_KEY_FILE env var",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_secret")),
+				},
+			},
+			"client_key_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Key id sent as `kid` in the client assertion. Can also be set with the CIDAAS_CLIENT_KEY_ID env var",
+			},
+			"client_assertion_algorithm": schema.StringAttribute{
+				Optional:    true,
+				Description: "Algorithm used to sign the client assertion, defaults to RS256 for RSA and ES256 for EC keys. Can also be set with the CIDAAS_CLIENT_ASSERTION_ALGORITHM env var",
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.ClientAssertionAlgorithms...),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the HTTP(S) proxy used to reach cidaas, defaults to the HTTPS_PROXY/HTTP_PROXY env vars. Can also be set with the CIDAAS_PROXY_URL env var",
+			},
+			"ca_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded CA certificates trusted in addition to the system certificates, e.g. of a TLS inspecting proxy",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_certificate_file")),
+				},
+			},
+			"ca_certificate_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file with PEM encoded CA certificates. Can also be set with the CIDAAS_CA_CERTIFICATE_FILE env var",
+			},
+			"client_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded client certificate presented for mTLS",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_certificate_file")),
+				},
+			},
+			"client_certificate_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM encoded client certificate presented for mTLS. Can also be set with the CIDAAS_CLIENT_CERTIFICATE_FILE env var",
+			},
+			"client_certificate_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key of the client certificate",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_certificate_key_file")),
+				},
+			},
+			"client_certificate_key_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to the PEM encoded private key of the client certificate. Can also be set with the CIDAAS_CLIENT_CERTIFICATE_KEY_FILE env var",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Disables the verification of the TLS certificate of cidaas. Only meant for local fakes",
+			},
+			"request_timeout_in_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Timeout of a single request to cidaas, defaults to 30 seconds",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"protected_tenant_keys": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Keys of tenants, like production, in which resources managing test data such as `cidaas_user` refuse to make changes. Can also be set as comma separated list with the CIDAAS_PROTECTED_TENANT_KEYS env var",
+			},
 		},
 	}
 }
 
 type providerData struct {
-	Host         types.String `tfsdk:"host"`
-	ClientId     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
+	Host            types.String `tfsdk:"host"`
+	Profile         types.String `tfsdk:"profile"`
+	CredentialsFile types.String `tfsdk:"credentials_file"`
+	ClientId        types.String `tfsdk:"client_id"`
+	ClientSecret    types.String `tfsdk:"client_secret"`
+	AccessToken     types.String `tfsdk:"access_token"`
+	AccessTokenFile types.String `tfsdk:"access_token_file"`
+
+	ClientPrivateKey         types.String `tfsdk:"client_private_key"`
+	ClientPrivateKeyFile     types.String `tfsdk:"client_private_key_file"`
+	ClientKeyId              types.String `tfsdk:"client_key_id"`
+	ClientAssertionAlgorithm types.String `tfsdk:"client_assertion_algorithm"`
+
+	ProxyUrl                 types.String `tfsdk:"proxy_url"`
+	CACertificate            types.String `tfsdk:"ca_certificate"`
+	CACertificateFile        types.String `tfsdk:"ca_certificate_file"`
+	ClientCertificate        types.String `tfsdk:"client_certificate"`
+	ClientCertificateFile    types.String `tfsdk:"client_certificate_file"`
+	ClientCertificateKey     types.String `tfsdk:"client_certificate_key"`
+	ClientCertificateKeyFile types.String `tfsdk:"client_certificate_key_file"`
+	InsecureSkipVerify       types.Bool   `tfsdk:"insecure_skip_verify"`
+	RequestTimeoutInSeconds  types.Int64  `tfsdk:"request_timeout_in_seconds"`
+
+	ProtectedTenantKeys []string `tfsdk:"protected_tenant_keys"`
 }
 
 func (p *cidaasProvider) Configure(ctx context.Context, req provider.ConfigureRequest, res *provider.ConfigureResponse) {
@@ -63,6 +203,8 @@ func (p *cidaasProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	res.ResourceData = p
 	res.DataSourceData = p
+	res.ListResourceData = p
+	res.EphemeralResourceData = p
 
 	diags := req.Config.Get(ctx, &config)
 	res.Diagnostics.Append(diags...)
@@ -71,62 +213,195 @@ func (p *cidaasProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	var host string
-	if config.Host.IsUnknown() {
+	if !req.Config.Raw.IsFullyKnown() {
+		// resources are planned again once the values are known, e.g. the host of a tenant created in the same run
+		if req.ClientCapabilities.DeferralAllowed {
+			res.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+			return
+		}
+
 		res.Diagnostics.AddWarning(
 			"unable to create client",
-			"Cannot use unknown value as host",
+			"Cannot use unknown values in the provider configuration. Resources of this provider can only be planned once "+
+				"they are known, use a Terraform version supporting deferred actions or apply the resources it depends on first.",
 		)
 		return
 	}
 
-	if config.Host.IsNull() {
-		host = os.Getenv("CIDAAS_HOST")
-	} else {
-		host = config.Host.ValueString()
+	profiles := []client.Profile{
+		{
+			Host:                     config.Host.ValueString(),
+			ClientId:                 config.ClientId.ValueString(),
+			ClientSecret:             config.ClientSecret.ValueString(),
+			AccessToken:              config.AccessToken.ValueString(),
+			AccessTokenFile:          config.AccessTokenFile.ValueString(),
+			ClientPrivateKey:         config.ClientPrivateKey.ValueString(),
+			ClientPrivateKeyFile:     config.ClientPrivateKeyFile.ValueString(),
+			ClientKeyId:              config.ClientKeyId.ValueString(),
+			ClientAssertionAlgorithm: config.ClientAssertionAlgorithm.ValueString(),
+			ProxyUrl:                 config.ProxyUrl.ValueString(),
+			CACertificate:            config.CACertificate.ValueString(),
+			CACertificateFile:        config.CACertificateFile.ValueString(),
+			ClientCertificate:        config.ClientCertificate.ValueString(),
+			ClientCertificateFile:    config.ClientCertificateFile.ValueString(),
+			ClientCertificateKey:     config.ClientCertificateKey.ValueString(),
+			ClientCertificateKeyFile: config.ClientCertificateKeyFile.ValueString(),
+			InsecureSkipVerify:       config.InsecureSkipVerify.ValueBoolPointer(),
+			RequestTimeoutInSeconds:  config.RequestTimeoutInSeconds.ValueInt64(),
+		},
 	}
 
-	var clientId string
-	if config.ClientId.IsUnknown() {
-		res.Diagnostics.AddWarning(
-			"unable to create client",
-			"Cannot use unknown value as client_id",
-		)
-		return
+	// a profile is chosen explicitly, so it wins over leftover CIDAAS_* env vars of another tenant
+	if profileName := valueOrEnv(config.Profile, "CIDAAS_PROFILE"); profileName != "" {
+		credentialsFile := config.CredentialsFile.ValueString()
+
+		if credentialsFile == "" {
+			var err error
+
+			credentialsFile, err = client.DefaultCredentialsFile()
+			if err != nil {
+				res.Diagnostics.AddError("unable to locate credentials file", err.Error())
+				return
+			}
+		}
+
+		profile, err := client.LoadProfile(credentialsFile, profileName)
+		if err != nil {
+			res.Diagnostics.AddAttributeError(path.Root("profile"), "unable to load profile", err.Error())
+			return
+		}
+
+		profiles = append(profiles, *profile)
 	}
 
-	if config.ClientId.IsNull() {
-		clientId = os.Getenv("CIDAAS_CLIENT_ID")
-	} else {
-		clientId = config.ClientId.ValueString()
+	profiles = append(profiles, client.ProfileFromEnv())
+
+	clientConfig, err := client.ResolveConfig(profiles...)
+	if err != nil {
+		res.Diagnostics.AddError("unable to create client", err.Error())
+		return
 	}
 
-	var clientSecret string
-	if config.ClientSecret.IsUnknown() {
-		res.Diagnostics.AddWarning(
-			"unable to create client",
-			"Cannot use unknown value as client_secret",
+	if clientConfig.Host == "" {
+		res.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"missing host",
+			"The host of the cidaas tenant has to be set in the provider block, a profile or the CIDAAS_HOST env var.",
 		)
 		return
 	}
 
-	if config.ClientSecret.IsNull() {
-		clientSecret = os.Getenv("CIDAAS_CLIENT_SECRET")
-	} else {
-		clientSecret = config.ClientSecret.ValueString()
+	p.clientConfig = clientConfig
+
+	// without credentials the provider can only be used for what doesn't manage the tenant, like cidaas_access_token.
+	// Everything else reports the missing credentials through isConfigured.
+	if !clientConfig.HasCredentials() {
+		return
 	}
 
-	c, err := client.NewClient(&host, &clientId, &clientSecret)
+	c, err := client.NewClient(clientConfig)
 
 	if err != nil {
 		res.Diagnostics.AddError(
 			"unable to create client",
-			"unable to create internal client: \n\n"+err.Error(),
+			"unable to create a client for "+clientConfig.Host+", check the host and credentials: \n\n"+err.Error(),
 		)
+		return
 	}
 
 	p.client = c
 	p.configured = true
+
+	p.protectedTenantKeys = config.ProtectedTenantKeys
+	if p.protectedTenantKeys == nil && os.Getenv("CIDAAS_PROTECTED_TENANT_KEYS") != "" {
+		p.protectedTenantKeys = strings.Split(os.Getenv("CIDAAS_PROTECTED_TENANT_KEYS"), ",")
+	}
+}
+
+// isConfigured reports an error if the provider has no client. This happens when the provider configuration depends on
+// values that are unknown during plan and Terraform doesn't support deferred actions, when no credentials are configured
+// or when creating the client failed.
+func (p *cidaasProvider) isConfigured(diags *diag.Diagnostics) bool {
+	if p != nil && p.configured {
+		return true
+	}
+
+	if p != nil && p.clientConfig.Host != "" && !p.clientConfig.HasCredentials() {
+		diags.AddError(
+			"No credentials configured",
+			"The cidaas provider has no credentials for "+p.clientConfig.Host+", so it can only request tokens with "+
+				"cidaas_access_token. Set client_id and client_secret, client_private_key or access_token in the provider "+
+				"block, a profile or the CIDAAS_* env vars to manage the tenant.",
+		)
+
+		return false
+	}
+
+	diags.AddError(
+		"Provider not configured",
+		"The cidaas provider has no client, either because its configuration depends on values that are not known yet "+
+			"or because signing in to the tenant failed, see the errors reported when configuring the provider.",
+	)
+
+	return false
+}
+
+// hasHost reports an error if the host of the tenant isn't known, which is all that is needed for requests that don't
+// use the credentials of the provider
+func (p *cidaasProvider) hasHost(diags *diag.Diagnostics) bool {
+	if p != nil && p.clientConfig.Host != "" {
+		return true
+	}
+
+	diags.AddError(
+		"Provider not configured",
+		"The host of the cidaas tenant is not known, either because the provider configuration depends on values that "+
+			"are not known yet or because configuring the provider failed.",
+	)
+
+	return false
+}
+
+// isUnprotectedTenant reports an error if the tenant is one of the protected_tenant_keys. Resources managing test data
+// check it before making changes, so that a module meant for a QA tenant can't be applied to production by accident.
+func (p *cidaasProvider) isUnprotectedTenant(diags *diag.Diagnostics) bool {
+	if len(p.protectedTenantKeys) == 0 {
+		return true
+	}
+
+	p.tenantInfoMutex.Lock()
+	defer p.tenantInfoMutex.Unlock()
+
+	if p.tenantInfo == nil {
+		info, err := p.client.GetTenantInfo()
+		if err != nil {
+			diags.AddError("Could not check whether the tenant is protected", err.Error())
+			return false
+		}
+
+		p.tenantInfo = info
+	}
+
+	for _, key := range p.protectedTenantKeys {
+		if strings.TrimSpace(key) == p.tenantInfo.TenantKey {
+			diags.AddError(
+				"Protected tenant",
+				fmt.Sprintf("The tenant %s is listed in protected_tenant_keys, test data can't be managed in it.", p.tenantInfo.TenantKey),
+			)
+			return false
+		}
+	}
+
+	return true
+}
+
+// valueOrEnv returns the configured value or the value of the environment variable if the attribute is not set
+func valueOrEnv(value types.String, env string) string {
+	if value.IsNull() {
+		return os.Getenv(env)
+	}
+
+	return value.ValueString()
 }
 
 func (p *cidaasProvider) Resources(context.Context) []func() resource.Resource {
@@ -138,16 +413,47 @@ func (p *cidaasProvider) Resources(context.Context) []func() resource.Resource {
 		NewRegistrationFieldResource,
 		NewTemplateGroupResource,
 		NewTemplateResource,
+		NewUserResource,
+		NewUserGroupMembershipResource,
+	}
+}
+
+func (p *cidaasProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
+	}
+}
+
+func (p *cidaasProvider) ListResources(context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewAppListResource,
+		NewHookListResource,
+		NewHostedPageGroupListResource,
+		NewTemplateListResource,
 	}
 }
 
 func (p *cidaasProvider) DataSources(context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAppDataSource,
+		NewAppsDataSource,
 		NewConsentInstanceDataSource,
+		NewHooksDataSource,
+		NewOpenIDConfigurationDataSource,
 		NewPasswordPolicyDataSource,
 		NewSocialProviderDataSource,
 		NewTenantInfoDataSource,
 		NewCustomProviderDataSource,
+		NewWebhookEventsDataSource,
+	}
+}
+
+func (p *cidaasProvider) Functions(context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewAuthorizeUrlFunction,
+		NewConsentLinkFunction,
+		NewDecodeJwtClaimsFunction,
+		NewOidcIssuerFunction,
 	}
 }
 
@@ -10,7 +10,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -27,6 +31,7 @@ type appResource struct {
 }
 
 var _ resource.Resource = (*appResource)(nil)
+var _ resource.ResourceWithIdentity = (*appResource)(nil)
 
 func NewAppResource() resource.Resource {
 	return &appResource{}
@@ -40,6 +45,16 @@ func (r *appResource) Configure(_ context.Context, req resource.ConfigureRequest
 	r.provider, resp.Diagnostics = toProvider(req.ProviderData)
 }
 
+func (r *appResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"client_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
@@ -58,7 +73,9 @@ func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 			},
 
 			"bot_provider": schema.StringAttribute{
-				Computed: true,
+				Optional:    true,
+				Computed:    true,
+				Description: "Provider used for bot detection",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -83,6 +100,22 @@ func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 			"accent_color": schema.StringAttribute{
 				Optional: true,
 			},
+			"logo_uri": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "URL of the logo shown on the hosted pages of the app",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"background_uri": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "URL of the background image shown on the hosted pages of the app",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"client_type": schema.StringAttribute{
 				Required: true,
 				Validators: []validator.String{
@@ -159,6 +192,14 @@ func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				ElementType: types.StringType,
 				Required:    true,
 			},
+			"enforce_pkce": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "If set, authorization code flows of the app have to use PKCE",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 
 			// Token Settings
 			"additional_access_token_payload": schema.ListAttribute{
@@ -166,9 +207,9 @@ func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Required:    true,
 			},
 			"token_lifetime_in_seconds": schema.Int64Attribute{
-				Required:   true,
+				Required: true,
 				Validators: []validator.Int64{
-					// validators.AtLeast(0),
+					int64validator.AtLeast(1),
 				},
 			},
 			"id_token_lifetime_in_seconds": schema.Int64Attribute{
@@ -183,12 +224,39 @@ func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 					int64validator.AtLeast(0),
 				},
 			},
+			"enable_refresh_token_rotation": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "If set, a new refresh token is issued every time a refresh token is used",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"refresh_token_reuse_interval_in_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Grace period in which a rotated refresh token can still be used",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 
 			// Consent management
 			"consent_refs": schema.ListAttribute{
 				ElementType: types.StringType,
 				Required:    true,
 			},
+			"consent_page_group": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Hosted page group used for the consent pages of the app",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 
 			// Template Group ID
 			"template_group_id": schema.StringAttribute{
@@ -210,6 +278,15 @@ func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 			},
 
 			// Login Provider
+			"login_providers": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Order in which the login providers are shown on the login page",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"social_providers": schema.ListNestedAttribute{
 				Required: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -240,6 +317,28 @@ func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 
 			// TODO: Guest login groups
 
+			"suggest_verification_methods": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Verification methods that are suggested to users after login. Removing it from the configuration resets the setting",
+				Attributes: map[string]schema.Attribute{
+					"mandatory_config": schema.SingleNestedAttribute{
+						Required:   true,
+						Attributes: verificationMethodsConfigSchema(),
+					},
+					"optional_config": schema.SingleNestedAttribute{
+						Required:   true,
+						Attributes: verificationMethodsConfigSchema(),
+					},
+					"skip_duration_in_days": schema.Int64Attribute{
+						Required:    true,
+						Description: "Number of days a user can postpone setting up the suggested methods",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
+			},
+
 			// Registration Fields
 			"allowed_fields": schema.ListAttribute{
 				ElementType: types.StringType,
@@ -279,6 +378,33 @@ func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"mfa": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Multi factor authentication settings of the app. Removing it from the configuration resets the setting",
+				Attributes: map[string]schema.Attribute{
+					"setting": schema.StringAttribute{
+						Required:    true,
+						Description: "When multi factor authentication is requested",
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"OFF", "ALWAYS", "SMART", "TIME_BASED", "SMART_PLUS_TIME_BASED",
+							),
+						},
+					},
+					"time_interval_in_seconds": schema.Int64Attribute{
+						Required:    true,
+						Description: "Interval after which multi factor authentication is requested again for time based settings",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"allowed_methods": schema.ListAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+						Description: "Methods that can be used as second factor",
+					},
+				},
+			},
 
 			// Remember Me
 			"is_remember_me_selected": schema.BoolAttribute{
@@ -312,6 +438,26 @@ func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 			"accept_roles_in_the_registration": schema.BoolAttribute{
 				Required: true,
 			},
+			"group_selection": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Controls which groups users can select during login. Removing it from the configuration resets the setting",
+				Attributes: map[string]schema.Attribute{
+					"always_show_group_selection": schema.BoolAttribute{
+						Required:    true,
+						Description: "If set, the group selection is shown even if only one group is selectable",
+					},
+					"selectable_groups": schema.ListAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+						Description: "Ids of groups that can be selected",
+					},
+					"selectable_group_types": schema.ListAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+						Description: "Group types whose groups can be selected",
+					},
+				},
+			},
 			"operations_allowed_groups": schema.ListNestedAttribute{
 				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -380,6 +526,58 @@ func (r *appResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 	}
 }
 
+func verificationMethodsConfigSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"methods": schema.ListAttribute{
+			ElementType: types.StringType,
+			Required:    true,
+			Description: "Verification methods that are suggested",
+		},
+		"range": schema.StringAttribute{
+			Required:    true,
+			Description: "Whether all or one of the methods have to be set up",
+			Validators: []validator.String{
+				stringvalidator.OneOf("ALLOF", "ONEOF"),
+			},
+		},
+	}
+}
+
+// interactiveGrantTypes are grants that act on behalf of a user and therefore need one to be present
+var interactiveGrantTypes = []string{"authorization_code", "implicit", "password"}
+
+// nativeClientTypes are client types that may register redirect URIs with custom schemes
+var nativeClientTypes = []string{"ANDROID", "IOS"}
+
+// responseTypeGrants maps response types to the grant type they belong to
+var responseTypeGrants = map[string]string{
+	"code":  "authorization_code",
+	"token": "implicit",
+}
+
+var appMfaAttrTypes = map[string]attr.Type{
+	"setting":                  types.StringType,
+	"time_interval_in_seconds": types.Int64Type,
+	"allowed_methods":          types.ListType{ElemType: types.StringType},
+}
+
+var groupSelectionAttrTypes = map[string]attr.Type{
+	"always_show_group_selection": types.BoolType,
+	"selectable_groups":           types.ListType{ElemType: types.StringType},
+	"selectable_group_types":      types.ListType{ElemType: types.StringType},
+}
+
+var verificationMethodsConfigAttrTypes = map[string]attr.Type{
+	"methods": types.ListType{ElemType: types.StringType},
+	"range":   types.StringType,
+}
+
+var suggestVerificationMethodsAttrTypes = map[string]attr.Type{
+	"mandatory_config":      types.ObjectType{AttrTypes: verificationMethodsConfigAttrTypes},
+	"optional_config":       types.ObjectType{AttrTypes: verificationMethodsConfigAttrTypes},
+	"skip_duration_in_days": types.Int64Type,
+}
+
 func (r appResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var allowedFields []string
 	var requiredFields []string
@@ -395,14 +593,155 @@ func (r appResource) ValidateConfig(ctx context.Context, req resource.ValidateCo
 			)
 		}
 	}
+
+	r.validateOAuthSettings(ctx, req, resp)
+	r.validateTokenLifetimes(ctx, req, resp)
 }
 
-func (r appResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+// validateOAuthSettings checks that client type, grant types, response types and the configured URLs form an app
+// cidaas can actually use. Checks depending on values that are not known yet are skipped.
+func (r appResource) validateOAuthSettings(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var clientType types.String
+
+	req.Config.GetAttribute(ctx, path.Root("client_type"), &clientType)
+
+	grantTypes, grantTypesKnown := knownStringList(ctx, req.Config, path.Root("grant_types"))
+	responseTypes, responseTypesKnown := knownStringList(ctx, req.Config, path.Root("response_types"))
+	redirectUris, redirectUrisKnown := knownStringList(ctx, req.Config, path.Root("redirect_uris"))
+	webOrigins, webOriginsKnown := knownStringList(ctx, req.Config, path.Root("allowed_web_origins"))
+
+	interactive := slices.Contains(grantTypes, "authorization_code") || slices.Contains(grantTypes, "implicit")
+
+	if grantTypesKnown && !clientType.IsUnknown() && clientType.ValueString() == "NON_INTERACTIVE" {
+		for _, grantType := range grantTypes {
+			if slices.Contains(interactiveGrantTypes, grantType) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("grant_types"),
+					"Grant type not supported by client type",
+					fmt.Sprintf("NON_INTERACTIVE apps can not use the %s grant, it requires user interaction", grantType),
+				)
+			}
+		}
+
+		if redirectUrisKnown && len(redirectUris) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("redirect_uris"),
+				"Redirect URIs not supported by client type",
+				"NON_INTERACTIVE apps never redirect users and must not define redirect_uris",
+			)
+		}
+	}
+
+	if grantTypesKnown && responseTypesKnown {
+		for _, responseType := range responseTypes {
+			requiredGrant, ok := responseTypeGrants[responseType]
+
+			if ok && !slices.Contains(grantTypes, requiredGrant) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("response_types"),
+					"Response type requires a different grant type",
+					fmt.Sprintf("the %s response type can only be used with the %s grant", responseType, requiredGrant),
+				)
+			}
+		}
+
+		if slices.Contains(grantTypes, "authorization_code") && !slices.Contains(responseTypes, "code") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("response_types"),
+				"Missing response type",
+				"the authorization_code grant requires the code response type",
+			)
+		}
+
+		if slices.Contains(grantTypes, "implicit") && !slices.Contains(responseTypes, "token") && !slices.Contains(responseTypes, "id_token") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("response_types"),
+				"Missing response type",
+				"the implicit grant requires the token or id_token response type",
+			)
+		}
+	}
+
+	if grantTypesKnown && redirectUrisKnown && interactive && len(redirectUris) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("redirect_uris"),
+			"Missing redirect URIs",
+			"the authorization_code and implicit grants require at least one redirect URI",
+		)
+	}
+
+	if redirectUrisKnown {
+		nativeApp := !clientType.IsUnknown() && slices.Contains(nativeClientTypes, clientType.ValueString())
+
+		for i, uri := range redirectUris {
+			if err := validateSecureURL(uri, nativeApp); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("redirect_uris").AtListIndex(i),
+					"Invalid redirect URI",
+					err.Error(),
+				)
+			}
+		}
+	}
+
+	if webOriginsKnown {
+		for i, origin := range webOrigins {
+			if err := validateOrigin(origin); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("allowed_web_origins").AtListIndex(i),
+					"Invalid web origin",
+					err.Error(),
+				)
+			}
+		}
+	}
+}
+
+// validateTokenLifetimes checks that the configured token lifetimes are consistent with each other.
+func (r appResource) validateTokenLifetimes(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var tokenLifetime types.Int64
+	var refreshTokenLifetime types.Int64
+	var reuseInterval types.Int64
+
+	req.Config.GetAttribute(ctx, path.Root("token_lifetime_in_seconds"), &tokenLifetime)
+	req.Config.GetAttribute(ctx, path.Root("refresh_token_lifetime_in_seconds"), &refreshTokenLifetime)
+	req.Config.GetAttribute(ctx, path.Root("refresh_token_reuse_interval_in_seconds"), &reuseInterval)
+
+	grantTypes, grantTypesKnown := knownStringList(ctx, req.Config, path.Root("grant_types"))
+
+	if refreshTokenLifetime.IsNull() || refreshTokenLifetime.IsUnknown() {
+		return
+	}
+
+	if grantTypesKnown && slices.Contains(grantTypes, "refresh_token") {
+		if refreshTokenLifetime.ValueInt64() == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("refresh_token_lifetime_in_seconds"),
+				"Invalid refresh token lifetime",
+				"the refresh_token grant requires a refresh token lifetime greater than zero",
+			)
+		}
+
+		if !tokenLifetime.IsNull() && !tokenLifetime.IsUnknown() && refreshTokenLifetime.ValueInt64() < tokenLifetime.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("refresh_token_lifetime_in_seconds"),
+				"Invalid refresh token lifetime",
+				"refresh tokens must not expire before the access tokens they are used to renew",
+			)
+		}
+	}
+
+	if !reuseInterval.IsNull() && !reuseInterval.IsUnknown() && reuseInterval.ValueInt64() > refreshTokenLifetime.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("refresh_token_reuse_interval_in_seconds"),
+			"Invalid refresh token reuse interval",
+			"the reuse interval can not be longer than the lifetime of the refresh token",
 		)
+	}
+}
+
+func (r appResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -431,7 +770,8 @@ func (r appResource) Create(ctx context.Context, req resource.CreateRequest, res
 		return
 	}
 
-	var state App
+	// the state starts from the plan, so that only the configured nested settings are tracked
+	state := plan
 
 	diags = applyAppToState(ctx, &state, app)
 	resp.Diagnostics.Append(diags...)
@@ -442,14 +782,13 @@ func (r appResource) Create(ctx context.Context, req resource.CreateRequest, res
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, AppIdentity{ClientId: state.ClientId})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r appResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -485,14 +824,13 @@ func (r appResource) Read(ctx context.Context, req resource.ReadRequest, resp *r
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, AppIdentity{ClientId: state.ClientId})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r appResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -518,23 +856,19 @@ func (r appResource) Update(ctx context.Context, req resource.UpdateRequest, res
 		return
 	}
 
-	diags = applyAppToState(ctx, &state, app)
+	diags = applyAppToState(ctx, &plan, app)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	diags = resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r appResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -557,26 +891,47 @@ func (r appResource) Delete(ctx context.Context, req resource.DeleteRequest, res
 }
 
 func (r appResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	var state App
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	state := newAppState()
+
+	clientId := importID(ctx, req, "client_id", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Trace(ctx, "fetching app")
 
-	app, err := r.provider.client.GetApp(req.ID)
+	app, err := r.provider.client.GetApp(clientId)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Error importing App", err.Error())
 		return
 	}
 
-	applyAppToState(ctx, &state, app)
+	diags := applyAppToState(ctx, &state, app)
+	resp.Diagnostics.Append(diags...)
 
-	if err != nil {
-		resp.Diagnostics.AddError("Error importing app", err.Error())
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, AppIdentity{ClientId: state.ClientId})
+	resp.Diagnostics.Append(diags...)
+}
+
+// newAppState returns the state of an app that doesn't track any of the nested settings yet, e.g. of an imported app
+func newAppState() App {
+	return App{
+		Mfa:                        types.ObjectNull(appMfaAttrTypes),
+		GroupSelection:             types.ObjectNull(groupSelectionAttrTypes),
+		SuggestVerificationMethods: types.ObjectNull(suggestVerificationMethodsAttrTypes),
+	}
 }
 
 func applyAppToState(ctx context.Context, state *App, app *client.App) diag.Diagnostics {
@@ -614,6 +969,31 @@ func applyAppToState(ctx context.Context, state *App, app *client.App) diag.Diag
 	state.IsLoginSuccessPageEnabled = types.BoolValue(app.IsLoginSuccessPageEnabled)
 	state.JweEnabled = types.BoolValue(app.JweEnabled)
 	state.AlwaysAskMfa = types.BoolValue(app.AlwaysAskMfa)
+	state.EnforcePkce = types.BoolPointerValue(app.EnforcePkce)
+	state.EnableRefreshTokenRotation = types.BoolPointerValue(app.EnableRefreshTokenRotation)
+	state.RefreshTokenReuseInterval = types.Int64PointerValue(app.RefreshTokenReuseInterval)
+	state.LogoUri = types.StringValue(app.LogoUri)
+	state.BackgroundUri = types.StringValue(app.BackgroundUri)
+	state.ConsentPageGroup = types.StringValue(app.ConsentPageGroup)
+
+	state.LoginProviders, diags = types.ListValueFrom(ctx, types.StringType, app.LoginProviders)
+	ret.Append(diags...)
+
+	// the nested settings are only tracked once they are configured, cidaas returns defaults for them otherwise
+	if !state.Mfa.IsNull() {
+		state.Mfa, diags = types.ObjectValueFrom(ctx, appMfaAttrTypes, app.Mfa)
+		ret.Append(diags...)
+	}
+
+	if !state.GroupSelection.IsNull() {
+		state.GroupSelection, diags = types.ObjectValueFrom(ctx, groupSelectionAttrTypes, app.GroupSelection)
+		ret.Append(diags...)
+	}
+
+	if !state.SuggestVerificationMethods.IsNull() {
+		state.SuggestVerificationMethods, diags = types.ObjectValueFrom(ctx, suggestVerificationMethodsAttrTypes, app.SuggestVerificationMethods)
+		ret.Append(diags...)
+	}
 
 	state.AllowedGroups, diags = types.ListValueFrom(ctx, types.ObjectType{
 		AttrTypes: map[string]attr.Type{
@@ -669,20 +1049,26 @@ func applyAppToState(ctx context.Context, state *App, app *client.App) diag.Diag
 		})
 	}
 
-	state.AppKey, diags = types.ObjectValue(
-		map[string]attr.Type{
-			"id":          types.StringType,
-			"private_key": types.StringType,
-			"public_key":  types.StringType,
-		},
-		map[string]attr.Value{
-			"id":          types.StringValue(app.AppKey.ID),
-			"private_key": types.StringValue(app.AppKey.PrivateKey),
-			"public_key":  types.StringValue(app.AppKey.PublicKey),
-		},
-	)
+	appKeyAttrTypes := map[string]attr.Type{
+		"id":          types.StringType,
+		"private_key": types.StringType,
+		"public_key":  types.StringType,
+	}
 
-	ret.Append(diags...)
+	if app.AppKey == nil {
+		state.AppKey = types.ObjectNull(appKeyAttrTypes)
+	} else {
+		state.AppKey, diags = types.ObjectValue(
+			appKeyAttrTypes,
+			map[string]attr.Value{
+				"id":          types.StringValue(app.AppKey.ID),
+				"private_key": types.StringValue(app.AppKey.PrivateKey),
+				"public_key":  types.StringValue(app.AppKey.PublicKey),
+			},
+		)
+
+		ret.Append(diags...)
+	}
 
 	return ret
 }
@@ -694,7 +1080,7 @@ func planToApp(ctx context.Context, plan *App, state *App) (*client.App, diag.Di
 	plannedApp := client.App{
 		ID:                              state.ID.ValueString(),
 		AppOwner:                        state.AppOwner.ValueString(),
-		BotProvider:                     state.BotProvider.ValueString(),
+		BotProvider:                     plan.BotProvider.ValueString(),
 		ClientSecret:                    state.ClientSecret.ValueString(),
 		ClientId:                        state.ClientId.ValueString(),
 		ClientDisplayName:               plan.ClientDisplayName.ValueString(),
@@ -722,6 +1108,9 @@ func planToApp(ctx context.Context, plan *App, state *App) (*client.App, diag.Di
 		AlwaysAskMfa:                    plan.AlwaysAskMfa.ValueBool(),
 		RegisterWithLoginInformation:    plan.RegisterWithLoginInformation.ValueBool(),
 		AcceptRolesInTheRegistration:    plan.AcceptRolesInTheRegistration.ValueBool(),
+		LogoUri:                         plan.LogoUri.ValueString(),
+		BackgroundUri:                   plan.BackgroundUri.ValueString(),
+		ConsentPageGroup:                plan.ConsentPageGroup.ValueString(),
 
 		AllowLoginWith:               plan.AllowLoginWith,
 		RedirectUris:                 plan.RedirectUris,
@@ -773,5 +1162,48 @@ func planToApp(ctx context.Context, plan *App, state *App) (*client.App, diag.Di
 	diags = tfsdk.ValueAs(ctx, plan.PasswordPolicy, &plannedApp.PasswordPolicy)
 	ret.Append(diags...)
 
+	// Unknown values are left empty so cidaas keeps its defaults for settings that aren't configured
+	if !plan.EnforcePkce.IsUnknown() {
+		plannedApp.EnforcePkce = plan.EnforcePkce.ValueBoolPointer()
+	}
+
+	if !plan.EnableRefreshTokenRotation.IsUnknown() {
+		plannedApp.EnableRefreshTokenRotation = plan.EnableRefreshTokenRotation.ValueBoolPointer()
+	}
+
+	if !plan.RefreshTokenReuseInterval.IsUnknown() {
+		plannedApp.RefreshTokenReuseInterval = plan.RefreshTokenReuseInterval.ValueInt64Pointer()
+	}
+
+	if !plan.LoginProviders.IsUnknown() {
+		diags = plan.LoginProviders.ElementsAs(ctx, &plannedApp.LoginProviders, false)
+		ret.Append(diags...)
+	}
+
+	// nested settings removed from the configuration are reset, leaving them out would keep them in cidaas
+	if !plan.Mfa.IsNull() {
+		diags = tfsdk.ValueAs(ctx, plan.Mfa, &plannedApp.Mfa)
+		ret.Append(diags...)
+	} else if !state.Mfa.IsNull() {
+		plannedApp.Mfa = &client.AppMfa{Setting: "OFF", AllowedMethods: []string{}}
+	}
+
+	if !plan.GroupSelection.IsNull() {
+		diags = tfsdk.ValueAs(ctx, plan.GroupSelection, &plannedApp.GroupSelection)
+		ret.Append(diags...)
+	} else if !state.GroupSelection.IsNull() {
+		plannedApp.GroupSelection = &client.GroupSelection{SelectableGroups: []string{}, SelectableGroupTypes: []string{}}
+	}
+
+	if !plan.SuggestVerificationMethods.IsNull() {
+		diags = tfsdk.ValueAs(ctx, plan.SuggestVerificationMethods, &plannedApp.SuggestVerificationMethods)
+		ret.Append(diags...)
+	} else if !state.SuggestVerificationMethods.IsNull() {
+		plannedApp.SuggestVerificationMethods = &client.SuggestVerificationMethods{
+			MandatoryConfig: client.VerificationMethodsConfig{Methods: []string{}},
+			OptionalConfig:  client.VerificationMethodsConfig{Methods: []string{}},
+		}
+	}
+
 	return &plannedApp, ret
 }
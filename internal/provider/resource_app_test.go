@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/real-digital/terraform-provider-cidaas/internal/client"
+)
+
+// fakeAppClient returns the same app for every request, all other requests of the client panic
+type fakeAppClient struct {
+	client.Client
+	app client.App
+}
+
+func (c *fakeAppClient) CreateApp(_ *client.App) (*client.App, error) {
+	app := c.app
+	return &app, nil
+}
+
+func (c *fakeAppClient) GetApp(_ string) (*client.App, error) {
+	app := c.app
+	return &app, nil
+}
+
+func newTestAppResource(t *testing.T) (appResource, resource.SchemaResponse, resource.IdentitySchemaResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+	r := appResource{provider: &cidaasProvider{
+		configured: true,
+		client: &fakeAppClient{app: client.App{
+			ID:         "app-id",
+			ClientId:   "client-id",
+			ClientName: "Test",
+			ClientType: "SINGLE_PAGE",
+			AppKey:     &client.AppKey{ID: "key-id"},
+			Mfa:        &client.AppMfa{Setting: "OFF", AllowedMethods: []string{}},
+		}},
+	}}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	return r, schemaResp, identityResp
+}
+
+// testAppPlan returns a plan in which all attributes but the given ones are null
+func testAppPlan(t *testing.T, schemaResp resource.SchemaResponse, values map[string]tftypes.Value) tfsdk.Plan {
+	t.Helper()
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		}
+	}
+
+	return tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+func TestAppCreateTracksOnlyConfiguredSettings(t *testing.T) {
+	ctx := context.Background()
+	r, schemaResp, identityResp := newTestAppResource(t)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	for name, values := range map[string]map[string]tftypes.Value{
+		"without mfa": {
+			"client_name": tftypes.NewValue(tftypes.String, "Test"),
+		},
+		"with mfa": {
+			"client_name": tftypes.NewValue(tftypes.String, "Test"),
+			"mfa": tftypes.NewValue(objectType.AttributeTypes["mfa"], map[string]tftypes.Value{
+				"setting":                  tftypes.NewValue(tftypes.String, "OFF"),
+				"time_interval_in_seconds": tftypes.NewValue(tftypes.Number, 0),
+				"allowed_methods":          tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+			}),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := resource.CreateRequest{Plan: testAppPlan(t, schemaResp, values)}
+			resp := resource.CreateResponse{
+				State:    tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+				Identity: &tfsdk.ResourceIdentity{Schema: identityResp.IdentitySchema},
+			}
+			resp.Identity.Raw = tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(ctx), nil)
+
+			r.Create(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var state App
+			resp.State.Get(ctx, &state)
+
+			if _, configured := values["mfa"]; state.Mfa.IsNull() == configured {
+				t.Errorf("mfa is tracked: %t, configured: %t", !state.Mfa.IsNull(), configured)
+			}
+
+			if state.ClientId.ValueString() != "client-id" {
+				t.Errorf("unexpected client id %q", state.ClientId.ValueString())
+			}
+		})
+	}
+}
+
+func TestAppImportState(t *testing.T) {
+	ctx := context.Background()
+	r, schemaResp, identityResp := newTestAppResource(t)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	resp := resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+		Identity: &tfsdk.ResourceIdentity{Schema: identityResp.IdentitySchema},
+	}
+	resp.Identity.Raw = tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(ctx), nil)
+
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "client-id"}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state App
+	resp.State.Get(ctx, &state)
+
+	if !state.Mfa.IsNull() || !state.GroupSelection.IsNull() || !state.SuggestVerificationMethods.IsNull() {
+		t.Error("imported apps must not track the nested settings")
+	}
+}
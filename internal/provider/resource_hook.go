@@ -2,14 +2,20 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/real-digital/terraform-provider-cidaas/internal/client"
+	"golang.org/x/exp/slices"
+	"strings"
 )
 
 type hookResource struct {
@@ -18,6 +24,8 @@ type hookResource struct {
 
 var _ resource.Resource = (*hookResource)(nil)
 var _ resource.ResourceWithImportState = (*hookResource)(nil)
+var _ resource.ResourceWithIdentity = (*hookResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*hookResource)(nil)
 
 func NewHookResource() resource.Resource {
 	return &hookResource{}
@@ -31,6 +39,16 @@ func (r *hookResource) Configure(_ context.Context, req resource.ConfigureReques
 	r.provider, resp.Diagnostics = toProvider(req.ProviderData)
 }
 
+func (r *hookResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *hookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "`cidaas_hook` manages webhooks in the tenant.\n\n" +
@@ -63,14 +81,19 @@ func (r *hookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"events": schema.ListAttribute{
 				ElementType: types.StringType,
 				Required:    true,
-				Description: "One or more hook events which will trigger the hook",
+				Description: "One or more hook events which will trigger the hook. See the `cidaas_webhook_events` data source for the events known to the provider, other events only cause a warning",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
 			},
 			"apikey_details": schema.SingleNestedAttribute{
-				Required: false,
-				Optional: true,
+				Required:    false,
+				Optional:    true,
+				Description: "Settings for the `APIKEY` auth type",
 				Attributes: map[string]schema.Attribute{
 					"apikey": schema.StringAttribute{
 						Required:    true,
+						Sensitive:   true,
 						Description: "apikey to measure and restrict access to the hook",
 					},
 					"apikey_placeholder": schema.StringAttribute{
@@ -88,16 +111,108 @@ func (r *hookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					},
 				},
 			},
+			"totp_details": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Settings for the `TOTP` auth type",
+				Attributes: map[string]schema.Attribute{
+					"totp_key": schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "shared secret the one time passwords are derived from",
+					},
+					"totp_placeholder": schema.StringAttribute{
+						Required:    true,
+						Description: "name of the attribute in which the one time password is to be provided",
+					},
+					"totp_placement": schema.StringAttribute{
+						Required:    true,
+						Description: "pass the one time password as query param or header param",
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"query", "header",
+							),
+						},
+					},
+				},
+			},
+			"cidaas_auth_details": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Settings for the `CIDAAS_OAUTH2` auth type",
+				Attributes: map[string]schema.Attribute{
+					"client_id": schema.StringAttribute{
+						Required:    true,
+						Description: "client id of the app whose access token is sent to the hook",
+					},
+					"scopes": schema.ListAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+						Description: "scopes requested for the access token",
+					},
+				},
+			},
 		},
 	}
 }
 
+// hookAuthDetails maps each auth type to the attribute holding its settings
+var hookAuthDetails = map[string]string{
+	"APIKEY":        "apikey_details",
+	"TOTP":          "totp_details",
+	"CIDAAS_OAUTH2": "cidaas_auth_details",
+}
+
+func (r hookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// events missing from the catalogue are only reported as warnings, cidaas may support events the provider doesn't
+	// know about yet
+	if events, ok := knownStringList(ctx, req.Config, path.Root("events")); ok {
+		for i, event := range events {
+			if !slices.Contains(client.WebhookEvents, event) {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("events").AtListIndex(i),
+					"Unknown hook event",
+					fmt.Sprintf("%s is not one of the events known to the provider, check that cidaas supports it", event),
+				)
+			}
+		}
+	}
+
+	var authType types.String
+
+	req.Config.GetAttribute(ctx, path.Root("auth_type"), &authType)
+
+	if authType.IsNull() || authType.IsUnknown() {
+		return
+	}
+
+	for detailsAuthType, attribute := range hookAuthDetails {
+		var details types.Object
+
+		req.Config.GetAttribute(ctx, path.Root(attribute), &details)
+
+		if details.IsUnknown() {
+			continue
+		}
+
+		if detailsAuthType == authType.ValueString() && details.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing auth details",
+				fmt.Sprintf("%s is required when auth_type is %s", attribute, detailsAuthType),
+			)
+		}
+
+		if detailsAuthType != authType.ValueString() && !details.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Unexpected auth details",
+				fmt.Sprintf("%s can only be set when auth_type is %s", attribute, detailsAuthType),
+			)
+		}
+	}
+}
+
 func (r hookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -110,18 +225,7 @@ func (r hookResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	plannedHook := client.Hook{
-		AuthType:    plan.AuthType,
-		Events:      plan.Events,
-		URL:         plan.Url,
-		CreatedTime: "",
-		UpdatedTime: "",
-		ApiKeyDetails: client.HookApiKeyDetails{
-			APIKey:            plan.APIKeyDetails.APIKey,
-			APIKeyPlaceholder: plan.APIKeyDetails.APIKeyPlaceholder,
-			APIKeyPlacement:   plan.APIKeyDetails.APIKeyPlacement,
-		},
-	}
+	plannedHook := planToHook(&plan)
 
 	hook, err := r.provider.client.UpsertHook(plannedHook)
 	if err != nil {
@@ -132,24 +236,21 @@ func (r hookResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	result := Hook{
-		ID:         types.StringValue(hook.Id),
-		LastUpdate: types.StringValue(hook.UpdatedTime),
-		Url:        hook.URL,
-		AuthType:   hook.AuthType,
-		Events:     hook.Events,
-		APIKeyDetails: HookAPIKeyDetails{
-			APIKey:            hook.ApiKeyDetails.APIKey,
-			APIKeyPlaceholder: hook.ApiKeyDetails.APIKeyPlaceholder,
-			APIKeyPlacement:   hook.ApiKeyDetails.APIKeyPlacement,
-		},
-	}
+	result := plan
+	applyHookToState(&result, hook)
 
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, HookIdentity{ID: result.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r hookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
 	var state Hook
 	diags := req.State.Get(ctx, &state)
 
@@ -169,26 +270,17 @@ func (r hookResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	state.Url = hook.URL
-	state.Events = hook.Events
-	state.LastUpdate = types.StringValue(hook.UpdatedTime)
-	state.AuthType = hook.AuthType
-
-	state.APIKeyDetails.APIKeyPlaceholder = hook.ApiKeyDetails.APIKeyPlaceholder
-	state.APIKeyDetails.APIKeyPlacement = hook.ApiKeyDetails.APIKeyPlacement
-	state.APIKeyDetails.APIKey = hook.ApiKeyDetails.APIKey
+	applyHookToState(&state, hook)
 
 	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 
+	diags = resp.Identity.Set(ctx, HookIdentity{ID: state.ID})
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r hookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -203,17 +295,8 @@ func (r hookResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
-	plannedHook := client.Hook{
-		Id:       state.ID.ValueString(),
-		AuthType: plan.AuthType,
-		Events:   plan.Events,
-		URL:      plan.Url,
-		ApiKeyDetails: client.HookApiKeyDetails{
-			APIKey:            plan.APIKeyDetails.APIKey,
-			APIKeyPlaceholder: plan.APIKeyDetails.APIKeyPlaceholder,
-			APIKeyPlacement:   plan.APIKeyDetails.APIKeyPlacement,
-		},
-	}
+	plannedHook := planToHook(&plan)
+	plannedHook.Id = state.ID.ValueString()
 
 	hook, err := r.provider.client.UpsertHook(plannedHook)
 	if err != nil {
@@ -224,29 +307,15 @@ func (r hookResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
-	result := Hook{
-		ID:         types.StringValue(hook.Id),
-		LastUpdate: types.StringValue(hook.UpdatedTime),
-		Url:        hook.URL,
-		AuthType:   hook.AuthType,
-		Events:     hook.Events,
-		APIKeyDetails: HookAPIKeyDetails{
-			APIKey:            hook.ApiKeyDetails.APIKey,
-			APIKeyPlaceholder: hook.ApiKeyDetails.APIKeyPlaceholder,
-			APIKeyPlacement:   hook.ApiKeyDetails.APIKeyPlacement,
-		},
-	}
+	result := plan
+	applyHookToState(&result, hook)
 
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r hookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -273,23 +342,112 @@ func (r hookResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r hookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
 	var state Hook
 
-	hook, err := r.provider.client.GetHook(req.ID)
+	hookID := importID(ctx, req, "id", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, err := r.provider.client.GetHook(hookID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error importing App", err.Error())
 		return
 	}
 
+	applyHookToState(&state, hook)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, HookIdentity{ID: state.ID})...)
+}
+
+func planToHook(plan *Hook) client.Hook {
+	hook := client.Hook{
+		AuthType: plan.AuthType,
+		Events:   plan.Events,
+		URL:      plan.Url,
+	}
+
+	if plan.APIKeyDetails != nil {
+		hook.ApiKeyDetails = client.HookApiKeyDetails{
+			APIKey:            plan.APIKeyDetails.APIKey,
+			APIKeyPlaceholder: plan.APIKeyDetails.APIKeyPlaceholder,
+			APIKeyPlacement:   plan.APIKeyDetails.APIKeyPlacement,
+		}
+	}
+
+	if plan.TotpDetails != nil {
+		hook.TotpDetails = client.HookTotpDetails{
+			TotpKey:         plan.TotpDetails.TotpKey,
+			TotpPlaceholder: plan.TotpDetails.TotpPlaceholder,
+			TotpPlacement:   plan.TotpDetails.TotpPlacement,
+		}
+	}
+
+	if plan.CidaasAuthDetails != nil {
+		hook.CidaasAuthDetails = client.HookCidaasAuthDetails{
+			ClientId: plan.CidaasAuthDetails.ClientId,
+			Scopes:   plan.CidaasAuthDetails.Scopes,
+		}
+	}
+
+	return hook
+}
+
+// applyHookToState updates state with the hook returned by cidaas. Secrets that cidaas masks in its responses are
+// kept from the previous state, so that they don't show up as changed in every plan.
+func applyHookToState(state *Hook, hook *client.Hook) {
 	state.ID = types.StringValue(hook.Id)
 	state.Url = hook.URL
 	state.Events = hook.Events
 	state.LastUpdate = types.StringValue(hook.UpdatedTime)
 	state.AuthType = hook.AuthType
 
-	state.APIKeyDetails.APIKeyPlaceholder = hook.ApiKeyDetails.APIKeyPlaceholder
-	state.APIKeyDetails.APIKeyPlacement = hook.ApiKeyDetails.APIKeyPlacement
-	state.APIKeyDetails.APIKey = hook.ApiKeyDetails.APIKey
+	previousAPIKeyDetails := state.APIKeyDetails
+	previousTotpDetails := state.TotpDetails
+
+	state.APIKeyDetails = nil
+	state.TotpDetails = nil
+	state.CidaasAuthDetails = nil
+
+	switch hook.AuthType {
+	case "APIKEY":
+		state.APIKeyDetails = &HookAPIKeyDetails{
+			APIKey:            hook.ApiKeyDetails.APIKey,
+			APIKeyPlaceholder: hook.ApiKeyDetails.APIKeyPlaceholder,
+			APIKeyPlacement:   hook.ApiKeyDetails.APIKeyPlacement,
+		}
+
+		if previousAPIKeyDetails != nil && isMaskedSecret(hook.ApiKeyDetails.APIKey) {
+			state.APIKeyDetails.APIKey = previousAPIKeyDetails.APIKey
+		}
+	case "TOTP":
+		state.TotpDetails = &HookTotpDetails{
+			TotpKey:         hook.TotpDetails.TotpKey,
+			TotpPlaceholder: hook.TotpDetails.TotpPlaceholder,
+			TotpPlacement:   hook.TotpDetails.TotpPlacement,
+		}
+
+		if previousTotpDetails != nil && isMaskedSecret(hook.TotpDetails.TotpKey) {
+			state.TotpDetails.TotpKey = previousTotpDetails.TotpKey
+		}
+	case "CIDAAS_OAUTH2":
+		state.CidaasAuthDetails = &HookCidaasAuthDetails{
+			ClientId: hook.CidaasAuthDetails.ClientId,
+			Scopes:   hook.CidaasAuthDetails.Scopes,
+		}
+
+		if state.CidaasAuthDetails.Scopes == nil {
+			state.CidaasAuthDetails.Scopes = []string{}
+		}
+	}
+}
 
-	resp.State.Set(ctx, state)
+// isMaskedSecret reports whether cidaas withheld a secret, either by omitting it or by returning a masked value.
+func isMaskedSecret(secret string) bool {
+	return secret == "" || strings.Contains(secret, "***")
 }
@@ -1,18 +1,28 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/real-digital/terraform-provider-cidaas/internal/client"
+	"golang.org/x/exp/slices"
+	"os"
+	"strings"
+	"text/template"
 )
 
 type hostedPageGroupResource struct {
@@ -20,11 +30,31 @@ type hostedPageGroupResource struct {
 }
 
 var _ resource.Resource = (*hostedPageGroupResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*hostedPageGroupResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*hostedPageGroupResource)(nil)
+var _ resource.ResourceWithUpgradeState = (*hostedPageGroupResource)(nil)
+var _ resource.ResourceWithIdentity = (*hostedPageGroupResource)(nil)
+
+// hostedPageIds are the pages cidaas allows to be customized
+var hostedPageIds = []string{
+	"consent_preview", "consent_scopes", "error", "login_success", "logout_success", "mfa_required", "password_forgot_init", "password_set_success", "register_additional_info", "register_success", "verification_init", "verification_complete", "password_set", "account_deduplication", "reactivate_verification_method", "device_init_code", "device_success_page", "status", "group_selection", "login", "register",
+}
+
+// requiredHostedPages are pages every group needs for its default locale, cidaas shows a blank page otherwise
+var requiredHostedPages = []string{"login", "error"}
 
 func NewHostedPageGroupResource() resource.Resource {
 	return &hostedPageGroupResource{}
 }
 
+var hostedPageAttrTypes = map[string]attr.Type{
+	"url":           types.StringType,
+	"content":       types.StringType,
+	"source_file":   types.StringType,
+	"template_vars": types.MapType{ElemType: types.StringType},
+	"content_hash":  types.StringType,
+}
+
 func (r *hostedPageGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_hpgroup"
 }
@@ -33,8 +63,19 @@ func (r *hostedPageGroupResource) Configure(_ context.Context, req resource.Conf
 	r.provider, resp.Diagnostics = toProvider(req.ProviderData)
 }
 
+func (r *hostedPageGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *hostedPageGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Required: true,
@@ -59,20 +100,11 @@ func (r *hostedPageGroupResource) Schema(_ context.Context, _ resource.SchemaReq
 				Required:    true,
 				Description: "Group owner of the hosted page group",
 			},
-			"hosted_pages": schema.ListNestedAttribute{
+			"hosted_pages": schema.MapNestedAttribute{
 				Required:    true,
-				Description: "List of hosted pages",
+				Description: "Hosted pages keyed by `<id>/<locale>`, e.g. `login/en-US`",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Required:    true,
-							Description: "Unique identifier of the hosted page",
-							Validators: []validator.String{
-								stringvalidator.OneOf(
-									"consent_preview", "consent_scopes", "error", "login_success", "logout_success", "mfa_required", "password_forgot_init", "password_set_success", "register_additional_info", "register_success", "verification_init", "verification_complete", "password_set", "account_deduplication", "reactivate_verification_method", "device_init_code", "device_success_page", "status", "group_selection", "login", "register",
-								),
-							},
-						},
 						"content": schema.StringAttribute{
 							Optional: true,
 							Computed: true,
@@ -80,10 +112,25 @@ func (r *hostedPageGroupResource) Schema(_ context.Context, _ resource.SchemaReq
 								stringplanmodifier.UseStateForUnknown(),
 							},
 							Description: "Content of the hosted page",
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("source_file")),
+							},
 						},
-						"locale": schema.StringAttribute{
-							Required:    true,
-							Description: "Locale of the hosted page",
+						"source_file": schema.StringAttribute{
+							Optional:    true,
+							Description: "Path to a file the content of the hosted page is rendered from. Only a hash of the rendered content is kept in the state",
+						},
+						"template_vars": schema.MapAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Variables available as `{{ .name }}` when rendering `source_file`",
+							Validators: []validator.Map{
+								mapvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("source_file")),
+							},
+						},
+						"content_hash": schema.StringAttribute{
+							Computed:    true,
+							Description: "SHA-256 hash of the content of the hosted page",
 						},
 						"url": schema.StringAttribute{
 							Optional:    true,
@@ -96,12 +143,199 @@ func (r *hostedPageGroupResource) Schema(_ context.Context, _ resource.SchemaReq
 	}
 }
 
-func (r hostedPageGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+func (r hostedPageGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var defaultLocale types.String
+	var hostedPages types.Map
+
+	req.Config.GetAttribute(ctx, path.Root("default_locale"), &defaultLocale)
+	req.Config.GetAttribute(ctx, path.Root("hosted_pages"), &hostedPages)
+
+	if hostedPages.IsNull() || hostedPages.IsUnknown() {
+		return
+	}
+
+	var pages map[string]HostedPage
+
+	diags := hostedPages.ElementsAs(ctx, &pages, false)
+	if diags.HasError() {
+		return
+	}
+
+	for key, page := range pages {
+		id, _, err := parseHostedPageKey(key)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("hosted_pages").AtMapKey(key), "Invalid hosted page key", err.Error())
+		} else if !slices.Contains(hostedPageIds, id) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("hosted_pages").AtMapKey(key),
+				"Invalid hosted page id",
+				fmt.Sprintf("%s is not a hosted page, valid ids are %s", id, strings.Join(hostedPageIds, ", ")),
+			)
+		}
+
+		if page.Url.IsNull() || page.Url.IsUnknown() {
+			continue
+		}
+
+		if err := validateSecureURL(page.Url.ValueString(), false); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("hosted_pages").AtMapKey(key).AtName("url"),
+				"Invalid hosted page URL",
+				err.Error(),
+			)
+		}
+	}
+
+	if defaultLocale.IsNull() || defaultLocale.IsUnknown() {
+		return
+	}
+
+	for _, id := range requiredHostedPages {
+		if _, ok := pages[hostedPageKey(id, defaultLocale.ValueString())]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("hosted_pages"),
+				"Missing hosted page",
+				fmt.Sprintf("a %s page is required for the default locale %s", id, defaultLocale.ValueString()),
+			)
+		}
+	}
+}
+
+func (r hostedPageGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plannedPages types.Map
+
+	diags := req.Plan.GetAttribute(ctx, path.Root("hosted_pages"), &plannedPages)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || plannedPages.IsUnknown() {
+		return
+	}
+
+	var pages map[string]HostedPage
+
+	diags = plannedPages.ElementsAs(ctx, &pages, false)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous map[string]HostedPage
+
+	if !req.State.Raw.IsNull() {
+		var statePages types.Map
+
+		diags = req.State.GetAttribute(ctx, path.Root("hosted_pages"), &statePages)
+		resp.Diagnostics.Append(diags...)
+
+		diags = statePages.ElementsAs(ctx, &previous, false)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	var changedFiles []string
+
+	for key := range pages {
+		page := pages[key]
+
+		if page.SourceFile.IsNull() {
+			if page.Content.IsUnknown() {
+				page.ContentHash = types.StringUnknown()
+			} else {
+				page.ContentHash = types.StringValue(hashContent(page.Content.ValueString()))
+			}
+
+			pages[key] = page
+			continue
+		}
+
+		page.Content = types.StringNull()
+		page.ContentHash = types.StringUnknown()
+
+		if isHostedPageKnown(&page) {
+			content, diags := renderHostedPage(ctx, &page)
+			resp.Diagnostics.Append(diags...)
+
+			if !diags.HasError() {
+				page.ContentHash = types.StringValue(hashContent(content))
+			}
+
+			prev, ok := previous[key]
+			if ok && !page.ContentHash.IsUnknown() && prev.ContentHash.ValueString() != page.ContentHash.ValueString() {
+				changedFiles = append(changedFiles, key)
+			}
+		}
+
+		pages[key] = page
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(changedFiles) > 0 {
+		r.warnAboutContentChanges(ctx, req, resp, pages, changedFiles)
+	}
+
+	plannedPages, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: hostedPageAttrTypes}, pages)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("hosted_pages"), plannedPages)
+	resp.Diagnostics.Append(diags...)
+}
+
+// warnAboutContentChanges adds a short summary of the differences between the remote content and the rendered files,
+// since only the hashes of file based pages are visible in the plan.
+func (r hostedPageGroupResource) warnAboutContentChanges(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, pages map[string]HostedPage, changed []string) {
+	if r.provider == nil || !r.provider.configured {
+		return
+	}
+
+	var groupId string
+
+	diags := req.State.GetAttribute(ctx, path.Root("id"), &groupId)
+	if diags.HasError() {
+		return
+	}
+
+	group, err := r.provider.client.GetHostedPagesGroup(groupId)
+	if err != nil {
+		return
+	}
+
+	for _, key := range changed {
+		page := pages[key]
+
+		remote := ""
+		for _, remotePage := range group.HostedPages {
+			if hostedPageKey(remotePage.ID, remotePage.Locale) == key {
+				remote = remotePage.Content
+			}
+		}
+
+		rendered, _ := renderHostedPage(ctx, &page)
+
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("hosted_pages").AtMapKey(key).AtName("content_hash"),
+			"Hosted page content differs",
+			fmt.Sprintf(
+				"The content of hosted page %s differs from %s:\n\n%s",
+				key, page.SourceFile.ValueString(), summarizeDiff(remote, rendered),
+			),
 		)
+	}
+}
+
+func (r hostedPageGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -118,7 +352,12 @@ func (r hostedPageGroupResource) Create(ctx context.Context, req resource.Create
 	plannedGroup.DefaultLocale = plan.DefaultLocale.ValueString()
 	plannedGroup.GroupOwner = plan.GroupOwner.ValueString()
 
-	diags = plan.HostedPages.ElementsAs(ctx, &plannedGroup.HostedPages, true)
+	var plannedPages map[string]HostedPage
+
+	diags = plan.HostedPages.ElementsAs(ctx, &plannedPages, false)
+	resp.Diagnostics.Append(diags...)
+
+	plannedGroup.HostedPages, diags = hostedPagesToClient(ctx, plannedPages)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
@@ -134,12 +373,6 @@ func (r hostedPageGroupResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	state := HostedPageGroup{
 		ID:            types.StringValue(group.ID),
 		GroupOwner:    types.StringValue(group.GroupOwner),
@@ -148,14 +381,8 @@ func (r hostedPageGroupResource) Create(ctx context.Context, req resource.Create
 		DefaultLocale: types.StringValue(group.DefaultLocale),
 	}
 
-	state.HostedPages, diags = types.ListValueFrom(ctx, types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"id":      types.StringType,
-			"content": types.StringType,
-			"locale":  types.StringType,
-			"url":     types.StringType,
-		},
-	}, group.HostedPages)
+	// The content that was just sent is used for the state, so the hashes match the plan
+	state.HostedPages, diags = hostedPagesToState(ctx, plannedGroup.HostedPages, plannedPages)
 
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -164,19 +391,26 @@ func (r hostedPageGroupResource) Create(ctx context.Context, req resource.Create
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, HostedPageGroupIdentity{ID: state.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r hostedPageGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var groupId string
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
 
-	diags := req.State.GetAttribute(ctx, path.Root("id"), &groupId)
+	var previous HostedPageGroup
+
+	diags := req.State.Get(ctx, &previous)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	group, err := r.provider.client.GetHostedPagesGroup(groupId)
+	group, err := r.provider.client.GetHostedPagesGroup(previous.ID.ValueString())
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -186,6 +420,11 @@ func (r hostedPageGroupResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	var previousPages map[string]HostedPage
+
+	diags = previous.HostedPages.ElementsAs(ctx, &previousPages, false)
+	resp.Diagnostics.Append(diags...)
+
 	state := HostedPageGroup{
 		ID:            types.StringValue(group.ID),
 		GroupOwner:    types.StringValue(group.GroupOwner),
@@ -194,14 +433,7 @@ func (r hostedPageGroupResource) Read(ctx context.Context, req resource.ReadRequ
 		DefaultLocale: types.StringValue(group.DefaultLocale),
 	}
 
-	state.HostedPages, diags = types.ListValueFrom(ctx, types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"id":      types.StringType,
-			"content": types.StringType,
-			"locale":  types.StringType,
-			"url":     types.StringType,
-		},
-	}, group.HostedPages)
+	state.HostedPages, diags = hostedPagesToState(ctx, group.HostedPages, previousPages)
 
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -209,17 +441,14 @@ func (r hostedPageGroupResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	diags = resp.State.Set(ctx, &state)
-
 	resp.Diagnostics.Append(diags...)
 
+	diags = resp.Identity.Set(ctx, HostedPageGroupIdentity{ID: state.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r hostedPageGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -238,7 +467,12 @@ func (r hostedPageGroupResource) Update(ctx context.Context, req resource.Update
 	plannedGroup.GroupOwner = plan.GroupOwner.ValueString()
 	plannedGroup.DefaultLocale = plan.DefaultLocale.ValueString()
 
-	diags = plan.HostedPages.ElementsAs(ctx, &plannedGroup.HostedPages, true)
+	var plannedPages map[string]HostedPage
+
+	diags = plan.HostedPages.ElementsAs(ctx, &plannedPages, false)
+	resp.Diagnostics.Append(diags...)
+
+	plannedGroup.HostedPages, diags = hostedPagesToClient(ctx, plannedPages)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
@@ -263,14 +497,7 @@ func (r hostedPageGroupResource) Update(ctx context.Context, req resource.Update
 		DefaultLocale: types.StringValue(group.DefaultLocale),
 	}
 
-	state.HostedPages, diags = types.ListValueFrom(ctx, types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"id":      types.StringType,
-			"content": types.StringType,
-			"locale":  types.StringType,
-			"url":     types.StringType,
-		},
-	}, group.HostedPages)
+	state.HostedPages, diags = hostedPagesToState(ctx, plannedGroup.HostedPages, plannedPages)
 
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -285,11 +512,7 @@ func (r hostedPageGroupResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r hostedPageGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -316,7 +539,16 @@ func (r hostedPageGroupResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r hostedPageGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	group, err := r.provider.client.GetHostedPagesGroup(req.ID)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	groupID := importID(ctx, req, "id", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.provider.client.GetHostedPagesGroup(groupID)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Error importing Hosted Page Group", err.Error())
@@ -333,16 +565,103 @@ func (r hostedPageGroupResource) ImportState(ctx context.Context, req resource.I
 		DefaultLocale: types.StringValue(group.DefaultLocale),
 	}
 
-	state.HostedPages, diags = types.ListValueFrom(ctx, types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"id":      types.StringType,
-			"content": types.StringType,
-			"locale":  types.StringType,
-			"url":     types.StringType,
+	state.HostedPages, diags = hostedPagesToState(ctx, group.HostedPages, nil)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, HostedPageGroupIdentity{ID: state.ID})
+	resp.Diagnostics.Append(diags...)
+}
+
+// hostedPageGroupV0 is the state of schema version 0, which kept hosted pages in a list
+type hostedPageGroupV0 struct {
+	ID            types.String   `tfsdk:"id"`
+	CreatedTime   types.String   `tfsdk:"created_time"`
+	UpdatedTime   types.String   `tfsdk:"updated_time"`
+	DefaultLocale types.String   `tfsdk:"default_locale"`
+	GroupOwner    types.String   `tfsdk:"group_owner"`
+	HostedPages   []hostedPageV0 `tfsdk:"hosted_pages"`
+}
+
+type hostedPageV0 struct {
+	ID      types.String `tfsdk:"id"`
+	Locale  types.String `tfsdk:"locale"`
+	Url     types.String `tfsdk:"url"`
+	Content types.String `tfsdk:"content"`
+}
+
+func (r hostedPageGroupResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":             schema.StringAttribute{Required: true},
+					"created_time":   schema.StringAttribute{Computed: true},
+					"updated_time":   schema.StringAttribute{Computed: true},
+					"default_locale": schema.StringAttribute{Required: true},
+					"group_owner":    schema.StringAttribute{Required: true},
+					"hosted_pages": schema.ListNestedAttribute{
+						Required: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id":      schema.StringAttribute{Required: true},
+								"content": schema.StringAttribute{Optional: true, Computed: true},
+								"locale":  schema.StringAttribute{Required: true},
+								"url":     schema.StringAttribute{Optional: true},
+							},
+						},
+					},
+				},
+			},
+			StateUpgrader: upgradeHostedPageGroupStateV0,
 		},
-	}, group.HostedPages)
+	}
+}
 
+func upgradeHostedPageGroupStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior hostedPageGroupV0
+
+	diags := req.State.Get(ctx, &prior)
 	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pages := make(map[string]HostedPage, len(prior.HostedPages))
+
+	for _, page := range prior.HostedPages {
+		contentHash := types.StringNull()
+		if !page.Content.IsNull() {
+			contentHash = types.StringValue(hashContent(page.Content.ValueString()))
+		}
+
+		pages[hostedPageKey(page.ID.ValueString(), page.Locale.ValueString())] = HostedPage{
+			Url:          page.Url,
+			Content:      page.Content,
+			SourceFile:   types.StringNull(),
+			TemplateVars: types.MapNull(types.StringType),
+			ContentHash:  contentHash,
+		}
+	}
+
+	state := HostedPageGroup{
+		ID:            prior.ID,
+		CreatedTime:   prior.CreatedTime,
+		UpdatedTime:   prior.UpdatedTime,
+		DefaultLocale: prior.DefaultLocale,
+		GroupOwner:    prior.GroupOwner,
+	}
+
+	state.HostedPages, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: hostedPageAttrTypes}, pages)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -350,3 +669,203 @@ func (r hostedPageGroupResource) ImportState(ctx context.Context, req resource.I
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// hostedPagesToClient converts the planned pages to the cidaas model, rendering the content of file based pages.
+func hostedPagesToClient(ctx context.Context, pages map[string]HostedPage) ([]client.HostedPage, diag.Diagnostics) {
+	ret := diag.Diagnostics{}
+	result := make([]client.HostedPage, 0, len(pages))
+
+	keys := make([]string, 0, len(pages))
+	for key := range pages {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		page := pages[key]
+
+		id, locale, err := parseHostedPageKey(key)
+		if err != nil {
+			ret.AddAttributeError(path.Root("hosted_pages").AtMapKey(key), "Invalid hosted page key", err.Error())
+			continue
+		}
+
+		content, diags := renderHostedPage(ctx, &page)
+		ret.Append(diags...)
+
+		result = append(result, client.HostedPage{
+			ID:      id,
+			Locale:  locale,
+			Url:     page.Url.ValueString(),
+			Content: content,
+		})
+	}
+
+	return result, ret
+}
+
+// hostedPagesToState converts hosted pages returned by cidaas to state. Pages that were previously rendered from a
+// file keep their source_file and template_vars and only store the hash of the content.
+func hostedPagesToState(ctx context.Context, pages []client.HostedPage, previous map[string]HostedPage) (types.Map, diag.Diagnostics) {
+	result := make(map[string]HostedPage, len(pages))
+
+	for _, page := range pages {
+		key := hostedPageKey(page.ID, page.Locale)
+
+		statePage := HostedPage{
+			Url:          types.StringNull(),
+			Content:      types.StringValue(page.Content),
+			SourceFile:   types.StringNull(),
+			TemplateVars: types.MapNull(types.StringType),
+			ContentHash:  types.StringValue(hashContent(page.Content)),
+		}
+
+		if page.Url != "" {
+			statePage.Url = types.StringValue(page.Url)
+		}
+
+		prev, ok := previous[key]
+		if ok && !prev.SourceFile.IsNull() {
+			statePage.Content = types.StringNull()
+			statePage.SourceFile = prev.SourceFile
+			statePage.TemplateVars = prev.TemplateVars
+		}
+
+		result[key] = statePage
+	}
+
+	return types.MapValueFrom(ctx, types.ObjectType{AttrTypes: hostedPageAttrTypes}, result)
+}
+
+func hostedPageKey(id string, locale string) string {
+	return id + "/" + locale
+}
+
+func parseHostedPageKey(key string) (string, string, error) {
+	id, locale, found := strings.Cut(key, "/")
+
+	if !found || id == "" || locale == "" {
+		return "", "", fmt.Errorf("%s is not a valid hosted page key, expected <id>/<locale>", key)
+	}
+
+	return id, locale, nil
+}
+
+// isHostedPageKnown reports whether everything needed to render the page is known.
+func isHostedPageKnown(page *HostedPage) bool {
+	if page.SourceFile.IsUnknown() || page.TemplateVars.IsUnknown() {
+		return false
+	}
+
+	for _, value := range page.TemplateVars.Elements() {
+		if value.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// renderHostedPage returns the content of the page, either inline or rendered from its source file.
+func renderHostedPage(ctx context.Context, page *HostedPage) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if page.SourceFile.IsNull() {
+		return page.Content.ValueString(), diags
+	}
+
+	source, err := os.ReadFile(page.SourceFile.ValueString())
+	if err != nil {
+		diags.AddError("Could not read hosted page source file", err.Error())
+		return "", diags
+	}
+
+	vars := map[string]string{}
+
+	if !page.TemplateVars.IsNull() {
+		diags = page.TemplateVars.ElementsAs(ctx, &vars, false)
+		if diags.HasError() {
+			return "", diags
+		}
+	}
+
+	tmpl, err := template.New(page.SourceFile.ValueString()).Option("missingkey=error").Parse(string(source))
+	if err != nil {
+		diags.AddError("Could not parse hosted page template", err.Error())
+		return "", diags
+	}
+
+	var rendered bytes.Buffer
+
+	err = tmpl.Execute(&rendered, vars)
+	if err != nil {
+		diags.AddError("Could not render hosted page template", err.Error())
+		return "", diags
+	}
+
+	return rendered.String(), diags
+}
+
+func hashContent(content string) string {
+	sum := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// summarizeDiff describes how two versions of a page differ in a few lines instead of printing both versions.
+func summarizeDiff(remote string, rendered string) string {
+	remoteLines := strings.Split(remote, "\n")
+	renderedLines := strings.Split(rendered, "\n")
+
+	changed := 0
+	first := -1
+
+	for i := 0; i < len(remoteLines) || i < len(renderedLines); i++ {
+		var remoteLine, renderedLine string
+
+		if i < len(remoteLines) {
+			remoteLine = remoteLines[i]
+		}
+
+		if i < len(renderedLines) {
+			renderedLine = renderedLines[i]
+		}
+
+		if remoteLine != renderedLine {
+			changed++
+
+			if first == -1 {
+				first = i
+			}
+		}
+	}
+
+	if first == -1 {
+		return "no line based differences"
+	}
+
+	return fmt.Sprintf(
+		"%d of %d lines differ (remote has %d lines), first difference in line %d:\n- %s\n+ %s",
+		changed, len(renderedLines), len(remoteLines), first+1,
+		truncateLine(lineAt(remoteLines, first)), truncateLine(lineAt(renderedLines, first)),
+	)
+}
+
+func lineAt(lines []string, i int) string {
+	if i < len(lines) {
+		return lines[i]
+	}
+
+	return ""
+}
+
+func truncateLine(line string) string {
+	line = strings.TrimSpace(line)
+
+	if len(line) > 120 {
+		return line[:117] + "..."
+	}
+
+	return line
+}
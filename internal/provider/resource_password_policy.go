@@ -2,10 +2,16 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/real-digital/terraform-provider-cidaas/internal/client"
 )
@@ -15,6 +21,8 @@ type passwordPolicyResource struct {
 }
 
 var _ resource.Resource = (*passwordPolicyResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*passwordPolicyResource)(nil)
+var _ resource.ResourceWithImportState = (*passwordPolicyResource)(nil)
 
 func NewPasswordPolicyResource() resource.Resource {
 	return &passwordPolicyResource{}
@@ -59,16 +67,91 @@ func (r *passwordPolicyResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Required:    true,
 				Description: "Number of special chars that need to be included in the password",
 			},
+			"maximum_length": optionalInt64Attribute("Maximum length of the passwords, 0 means no limit"),
+			"reuse_limit":    optionalInt64Attribute("Number of previous passwords that cannot be reused, 0 allows reusing any password"),
+			"expiration_in_days": optionalInt64Attribute(
+				"Number of days after which users have to change their password, 0 means passwords never expire",
+			),
+			"block_compromised": optionalBoolAttribute("Indicates if passwords known from data breaches are rejected"),
+			"disallow_username": optionalBoolAttribute("Indicates if passwords containing the username are rejected"),
+			"disallow_email":    optionalBoolAttribute("Indicates if passwords containing the email address are rejected"),
+			"max_failed_attempts": optionalInt64Attribute(
+				"Number of failed login attempts after which the account is locked, 0 disables the lockout",
+			),
+			"lockout_duration_in_minutes": optionalInt64Attribute("Duration an account stays locked after too many failed login attempts"),
 		},
 	}
 }
 
-func (r *passwordPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+func optionalInt64Attribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func optionalBoolAttribute(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r passwordPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var minimumLength, maximumLength, noOfDigits, noOfSpecialChars types.Int64
+	var maxFailedAttempts, lockoutDuration types.Int64
+
+	req.Config.GetAttribute(ctx, path.Root("minimum_length"), &minimumLength)
+	req.Config.GetAttribute(ctx, path.Root("maximum_length"), &maximumLength)
+	req.Config.GetAttribute(ctx, path.Root("no_of_digits"), &noOfDigits)
+	req.Config.GetAttribute(ctx, path.Root("no_of_special_chars"), &noOfSpecialChars)
+	req.Config.GetAttribute(ctx, path.Root("max_failed_attempts"), &maxFailedAttempts)
+	req.Config.GetAttribute(ctx, path.Root("lockout_duration_in_minutes"), &lockoutDuration)
+
+	if isKnownInt64(maximumLength) && maximumLength.ValueInt64() > 0 {
+		if isKnownInt64(minimumLength) && minimumLength.ValueInt64() > maximumLength.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("maximum_length"),
+				"Invalid password length",
+				fmt.Sprintf("maximum_length %d is smaller than minimum_length %d", maximumLength.ValueInt64(), minimumLength.ValueInt64()),
+			)
+		}
+
+		if isKnownInt64(noOfDigits) && isKnownInt64(noOfSpecialChars) && noOfDigits.ValueInt64()+noOfSpecialChars.ValueInt64() > maximumLength.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("maximum_length"),
+				"Invalid password length",
+				"no_of_digits and no_of_special_chars together exceed maximum_length, no password could satisfy the policy",
+			)
+		}
+	}
+
+	if isKnownInt64(lockoutDuration) && lockoutDuration.ValueInt64() > 0 && isKnownInt64(maxFailedAttempts) && maxFailedAttempts.ValueInt64() == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("lockout_duration_in_minutes"),
+			"Invalid lockout configuration",
+			"lockout_duration_in_minutes requires max_failed_attempts to be set",
 		)
+	}
+}
+
+func isKnownInt64(value types.Int64) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+func (r *passwordPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -81,13 +164,7 @@ func (r *passwordPolicyResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	plannedPolicy := client.PasswordPolicy{
-		PolicyName:        plan.PolicyName.ValueString(),
-		MinimumLength:     plan.MinimumLength.ValueInt64(),
-		NoOfDigits:        plan.NoOfDigits.ValueInt64(),
-		LowerAndUpperCase: plan.LowerAndUpperCase.ValueBool(),
-		NoOfSpecialChars:  plan.NoOfSpecialChars.ValueInt64(),
-	}
+	plannedPolicy := planToPasswordPolicy(plan)
 
 	policy, err := r.provider.client.UpdatePasswordPolicy(plannedPolicy)
 	if err != nil {
@@ -98,14 +175,8 @@ func (r *passwordPolicyResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	result := PasswordPolicy{
-		ID:                types.StringValue(policy.ID),
-		PolicyName:        types.StringValue(policy.PolicyName),
-		MinimumLength:     types.Int64Value(policy.MinimumLength),
-		NoOfDigits:        types.Int64Value(policy.NoOfDigits),
-		LowerAndUpperCase: types.BoolValue(policy.LowerAndUpperCase),
-		NoOfSpecialChars:  types.Int64Value(policy.NoOfSpecialChars),
-	}
+	var result PasswordPolicy
+	applyPasswordPolicyToState(&result, policy)
 
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
@@ -115,6 +186,10 @@ func (r *passwordPolicyResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r passwordPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
 	var state PasswordPolicy
 	diags := req.State.Get(ctx, &state)
 
@@ -134,12 +209,7 @@ func (r passwordPolicyResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	state.ID = types.StringValue(policy.ID)
-	state.PolicyName = types.StringValue(policy.PolicyName)
-	state.LowerAndUpperCase = types.BoolValue(policy.LowerAndUpperCase)
-	state.MinimumLength = types.Int64Value(policy.MinimumLength)
-	state.NoOfDigits = types.Int64Value(policy.NoOfDigits)
-	state.NoOfSpecialChars = types.Int64Value(policy.NoOfSpecialChars)
+	applyPasswordPolicyToState(&state, policy)
 
 	diags = resp.State.Set(ctx, &state)
 
@@ -151,11 +221,7 @@ func (r passwordPolicyResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r passwordPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -170,14 +236,8 @@ func (r passwordPolicyResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	plannedPolicy := client.PasswordPolicy{
-		ID:                state.ID.ValueString(),
-		PolicyName:        plan.PolicyName.ValueString(),
-		MinimumLength:     plan.MinimumLength.ValueInt64(),
-		NoOfDigits:        plan.NoOfDigits.ValueInt64(),
-		LowerAndUpperCase: plan.LowerAndUpperCase.ValueBool(),
-		NoOfSpecialChars:  plan.NoOfSpecialChars.ValueInt64(),
-	}
+	plannedPolicy := planToPasswordPolicy(plan)
+	plannedPolicy.ID = state.ID.ValueString()
 
 	policy, err := r.provider.client.UpdatePasswordPolicy(plannedPolicy)
 	if err != nil {
@@ -188,14 +248,9 @@ func (r passwordPolicyResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	result := PasswordPolicy{
-		ID:                state.ID,
-		PolicyName:        types.StringValue(policy.PolicyName),
-		MinimumLength:     types.Int64Value(policy.MinimumLength),
-		NoOfDigits:        types.Int64Value(policy.NoOfDigits),
-		LowerAndUpperCase: types.BoolValue(policy.LowerAndUpperCase),
-		NoOfSpecialChars:  types.Int64Value(policy.NoOfSpecialChars),
-	}
+	var result PasswordPolicy
+	applyPasswordPolicyToState(&result, policy)
+	result.ID = state.ID
 
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
@@ -205,11 +260,7 @@ func (r passwordPolicyResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r passwordPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -234,3 +285,60 @@ func (r passwordPolicyResource) Delete(ctx context.Context, req resource.DeleteR
 
 	resp.State.RemoveResource(ctx)
 }
+
+func planToPasswordPolicy(plan PasswordPolicy) client.PasswordPolicy {
+	return client.PasswordPolicy{
+		PolicyName:               plan.PolicyName.ValueString(),
+		MinimumLength:            plan.MinimumLength.ValueInt64(),
+		NoOfDigits:               plan.NoOfDigits.ValueInt64(),
+		LowerAndUpperCase:        plan.LowerAndUpperCase.ValueBool(),
+		NoOfSpecialChars:         plan.NoOfSpecialChars.ValueInt64(),
+		MaximumLength:            knownInt64Pointer(plan.MaximumLength),
+		ReuseLimit:               knownInt64Pointer(plan.ReuseLimit),
+		ExpirationInDays:         knownInt64Pointer(plan.ExpirationInDays),
+		BlockCompromised:         knownBoolPointer(plan.BlockCompromised),
+		DisallowUsername:         knownBoolPointer(plan.DisallowUsername),
+		DisallowEmail:            knownBoolPointer(plan.DisallowEmail),
+		MaxFailedAttempts:        knownInt64Pointer(plan.MaxFailedAttempts),
+		LockoutDurationInMinutes: knownInt64Pointer(plan.LockoutDurationInMinutes),
+	}
+}
+
+// knownInt64Pointer returns nil for null and unknown values, so that they are left out of requests
+func knownInt64Pointer(value types.Int64) *int64 {
+	if value.IsUnknown() {
+		return nil
+	}
+
+	return value.ValueInt64Pointer()
+}
+
+// knownBoolPointer returns nil for null and unknown values, so that they are left out of requests
+func knownBoolPointer(value types.Bool) *bool {
+	if value.IsUnknown() {
+		return nil
+	}
+
+	return value.ValueBoolPointer()
+}
+
+func applyPasswordPolicyToState(state *PasswordPolicy, policy *client.PasswordPolicy) {
+	state.ID = types.StringValue(policy.ID)
+	state.PolicyName = types.StringValue(policy.PolicyName)
+	state.LowerAndUpperCase = types.BoolValue(policy.LowerAndUpperCase)
+	state.MinimumLength = types.Int64Value(policy.MinimumLength)
+	state.NoOfDigits = types.Int64Value(policy.NoOfDigits)
+	state.NoOfSpecialChars = types.Int64Value(policy.NoOfSpecialChars)
+	state.MaximumLength = types.Int64PointerValue(policy.MaximumLength)
+	state.ReuseLimit = types.Int64PointerValue(policy.ReuseLimit)
+	state.ExpirationInDays = types.Int64PointerValue(policy.ExpirationInDays)
+	state.BlockCompromised = types.BoolPointerValue(policy.BlockCompromised)
+	state.DisallowUsername = types.BoolPointerValue(policy.DisallowUsername)
+	state.DisallowEmail = types.BoolPointerValue(policy.DisallowEmail)
+	state.MaxFailedAttempts = types.Int64PointerValue(policy.MaxFailedAttempts)
+	state.LockoutDurationInMinutes = types.Int64PointerValue(policy.LockoutDurationInMinutes)
+}
+
+func (r passwordPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +21,7 @@ type resourceRegistrationField struct {
 }
 
 var _ resource.Resource = (*resourceRegistrationField)(nil)
+var _ resource.ResourceWithImportState = (*resourceRegistrationField)(nil)
 
 func NewRegistrationFieldResource() resource.Resource {
 	return &resourceRegistrationField{}
@@ -94,11 +96,7 @@ func (r *resourceRegistrationField) Schema(_ context.Context, _ resource.SchemaR
 }
 
 func (r resourceRegistrationField) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -144,11 +142,7 @@ func (r resourceRegistrationField) Create(ctx context.Context, req resource.Crea
 }
 
 func (r resourceRegistrationField) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -182,11 +176,7 @@ func (r resourceRegistrationField) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r resourceRegistrationField) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -237,11 +227,7 @@ func (r resourceRegistrationField) Update(ctx context.Context, req resource.Upda
 }
 
 func (r resourceRegistrationField) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -281,3 +267,7 @@ func (field *RegistrationField) FromClient(ctx context.Context, crf *client.Regi
 
 	return diags
 }
+
+func (r resourceRegistrationField) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("field_key"), req, resp)
+}
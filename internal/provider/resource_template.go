@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -10,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/real-digital/terraform-provider-cidaas/internal/client"
 	"github.com/real-digital/terraform-provider-cidaas/internal/util"
+	"strings"
 )
 
 type templateResource struct {
@@ -17,6 +20,8 @@ type templateResource struct {
 }
 
 var _ resource.Resource = (*templateResource)(nil)
+var _ resource.ResourceWithImportState = (*templateResource)(nil)
+var _ resource.ResourceWithIdentity = (*templateResource)(nil)
 
 func NewTemplateResource() resource.Resource {
 	return &templateResource{}
@@ -30,6 +35,18 @@ func (r *templateResource) Configure(_ context.Context, req resource.ConfigureRe
 	r.provider, resp.Diagnostics = toProvider(req.ProviderData)
 }
 
+func (r *templateResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"group_id":        identityschema.StringAttribute{RequiredForImport: true},
+			"template_key":    identityschema.StringAttribute{RequiredForImport: true},
+			"template_type":   identityschema.StringAttribute{RequiredForImport: true},
+			"locale":          identityschema.StringAttribute{RequiredForImport: true},
+			"processing_type": identityschema.StringAttribute{OptionalForImport: true},
+		},
+	}
+}
+
 func (r *templateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "`cidaas_template_group` manages Template Groups in the tenant.\n\n",
@@ -48,23 +65,38 @@ func (r *templateResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"group_id": schema.StringAttribute{
-				Required:    true,
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Description: "Group of this template",
 			},
 			"template_key": schema.StringAttribute{
-				Required:    true,
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Description: "Identifier of the template",
 			},
 			"template_type": schema.StringAttribute{
-				Required:    true,
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Description: "Which Communication type is this template for",
 			},
 			"processing_type": schema.StringAttribute{
-				Optional:    true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Description: "Processing Type",
 			},
 			"locale": schema.StringAttribute{
-				Required:    true,
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Description: "Locale",
 			},
 			"usage_type": schema.StringAttribute{
@@ -87,11 +119,7 @@ func (r *templateResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 }
 
 func (r templateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -133,9 +161,16 @@ func (r templateResource) Create(ctx context.Context, req resource.CreateRequest
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, templateIdentity(&plan))
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r templateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
 	var state Template
 	diags := req.State.Get(ctx, &state)
 
@@ -169,17 +204,20 @@ func (r templateResource) Read(ctx context.Context, req resource.ReadRequest, re
 	tfsdk.ValueFrom(ctx, template.Subject, types.StringType, &state.Subject)
 	tfsdk.ValueFrom(ctx, template.Content, types.StringType, &state.Content)
 
+	// imported templates only know the attributes of the import id
+	if state.Language.IsNull() {
+		tfsdk.ValueFrom(ctx, template.Language, types.StringType, &state.Language)
+	}
+
 	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 
+	diags = resp.Identity.Set(ctx, templateIdentity(&state))
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r templateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -221,13 +259,51 @@ func (r templateResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r templateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	if !r.provider.configured {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports templates by `<group_id>/<template_key>/<template_type>/<locale>[/<processing_type>]`
+func (r templateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" && req.Identity != nil {
+		var identity TemplateIdentity
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), identity.GroupId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_key"), identity.TemplateKey)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_type"), identity.TemplateType)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("locale"), identity.Locale)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("processing_type"), identity.ProcessingType)...)
+		return
+	}
+
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 4 && len(parts) != 5 {
 		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+			"Invalid import id",
+			"Expected <group_id>/<template_key>/<template_type>/<locale>[/<processing_type>], got "+req.ID,
 		)
 		return
 	}
 
-	resp.State.RemoveResource(ctx)
+	attributes := []string{"group_id", "template_key", "template_type", "locale", "processing_type"}
+
+	for i, part := range parts {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attributes[i]), part)...)
+	}
+}
+
+func templateIdentity(template *Template) TemplateIdentity {
+	return TemplateIdentity{
+		GroupId:        template.GroupId,
+		TemplateKey:    template.TemplateKey,
+		TemplateType:   template.TemplateType,
+		Locale:         template.Locale,
+		ProcessingType: template.ProcessingType,
+	}
 }
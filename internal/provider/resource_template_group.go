@@ -132,11 +132,7 @@ func (r *templateGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 }
 
 func (r templateGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -168,6 +164,10 @@ func (r templateGroupResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r templateGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
 	var state TemplateGroup
 	diags := req.State.Get(ctx, &state)
 
@@ -196,11 +196,7 @@ func (r templateGroupResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r templateGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -264,11 +260,7 @@ func (r templateGroupResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r templateGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	if !r.provider.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.provider.isConfigured(&resp.Diagnostics) {
 		return
 	}
 
@@ -295,6 +287,10 @@ func (r templateGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r templateGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
 	group, err := r.provider.client.GetTemplateGroup(req.ID)
 
 	if err != nil {
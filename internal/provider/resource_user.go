@@ -0,0 +1,397 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/real-digital/terraform-provider-cidaas/internal/client"
+	"golang.org/x/exp/slices"
+)
+
+type userResource struct {
+	provider *cidaasProvider
+}
+
+var _ resource.Resource = (*userResource)(nil)
+var _ resource.ResourceWithImportState = (*userResource)(nil)
+
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *userResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.provider, resp.Diagnostics = toProvider(req.ProviderData)
+}
+
+func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "`cidaas_user` manages users, e.g. test accounts of E2E environments. It refuses to make changes " +
+			"in tenants listed in the `protected_tenant_keys` of the provider.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Sub of the user",
+			},
+			"email": schema.StringAttribute{
+				Required:    true,
+				Description: "Email address of the user",
+			},
+			"email_verified":         optionalBoolAttribute("Indicates if the email address is verified"),
+			"mobile_number":          schema.StringAttribute{Optional: true, Description: "Mobile number of the user"},
+			"mobile_number_verified": optionalBoolAttribute("Indicates if the mobile number is verified"),
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Description: "Username the user can sign in with",
+			},
+			"password": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of the user. cidaas doesn't return passwords, so changes made outside of Terraform aren't detected",
+			},
+			"given_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Given name of the user",
+			},
+			"family_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Family name of the user",
+			},
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Roles of the user",
+			},
+			"groups": schema.SetNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.StringAttribute{
+							Required:    true,
+							Description: "Id of the group",
+						},
+						"roles": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "Roles of the user in the group",
+						},
+					},
+				},
+				Description: "Memberships of the user in groups. Only the listed groups are managed, memberships in other " +
+					"groups, e.g. the default groups of the tenant, are kept",
+			},
+			"custom_fields": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Values of custom registration fields, keyed by field key. Only the listed fields are managed",
+			},
+		},
+	}
+}
+
+func (r userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) || !r.provider.isUnprotectedTenant(&resp.Diagnostics) {
+		return
+	}
+
+	var plan User
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plannedUser, diags := planToUser(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.provider.client.CreateUser(plannedUser)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not create user", err.Error())
+		return
+	}
+
+	// the user exists from here on, so it's kept in the state even if setting it up fails
+	state := plan
+	state.ID = types.StringValue(created.Sub)
+	state.EmailVerified = types.BoolValue(plannedUser.EmailVerified)
+	state.MobileNumberVerified = types.BoolValue(plannedUser.MobileNumberVerified)
+
+	err = r.syncGroups(created.Sub, plan.Groups, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not add user to groups", err.Error())
+	}
+
+	// read even if adding the groups failed, so that the state reflects what was set up
+	_, diags = r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	var state User
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// users deleted outside of Terraform are created again
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) || !r.provider.isUnprotectedTenant(&resp.Diagnostics) {
+		return
+	}
+
+	var plan User
+	var state User
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plannedUser, diags := planToUser(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plannedUser.Sub = state.ID.ValueString()
+
+	err := r.provider.client.UpdateUser(plannedUser)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not update user", err.Error())
+		return
+	}
+
+	err = r.syncGroups(plannedUser.Sub, plan.Groups, state.Groups)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not update groups of user", err.Error())
+		return
+	}
+
+	_, diags = r.read(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) || !r.provider.isUnprotectedTenant(&resp.Diagnostics) {
+		return
+	}
+
+	var state User
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.provider.client.DeleteUser(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Could not delete user", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// read refreshes state from cidaas and reports whether the user still exists. Groups and custom fields are only
+// refreshed for the entries in state, as users usually have memberships and fields that aren't managed by Terraform.
+func (r userResource) read(ctx context.Context, state *User) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	user, err := r.provider.client.GetUser(state.ID.ValueString())
+	if client.IsNotFound(err) {
+		return false, diags
+	}
+
+	if err != nil {
+		diags.AddError("Could not read user", err.Error())
+		return true, diags
+	}
+
+	state.Email = types.StringValue(user.Email)
+	state.EmailVerified = types.BoolValue(user.EmailVerified)
+	state.MobileNumber = optionalString(user.MobileNumber)
+	state.MobileNumberVerified = types.BoolValue(user.MobileNumberVerified)
+	state.Username = optionalString(user.Username)
+	state.GivenName = optionalString(user.GivenName)
+	state.FamilyName = optionalString(user.FamilyName)
+
+	roles := user.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+
+	state.Roles, diags = types.SetValueFrom(ctx, types.StringType, roles)
+	if diags.HasError() {
+		return true, diags
+	}
+
+	if state.CustomFields != nil {
+		customFields := map[string]string{}
+
+		for key := range state.CustomFields {
+			if value, ok := user.CustomFields[key]; ok && value != nil {
+				customFields[key] = fmt.Sprint(value)
+			}
+		}
+
+		state.CustomFields = customFields
+	}
+
+	if state.Groups != nil {
+		memberships, err := r.provider.client.GetUserGroups(state.ID.ValueString())
+		if err != nil {
+			diags.AddError("Could not read groups of user", err.Error())
+			return true, diags
+		}
+
+		groups := []UserGroup{}
+
+		for _, group := range state.Groups {
+			index := slices.IndexFunc(memberships, func(m client.UserGroupMembership) bool {
+				return m.GroupId == group.GroupId.ValueString()
+			})
+
+			if index < 0 {
+				continue
+			}
+
+			roles := memberships[index].Roles
+			if roles == nil {
+				roles = []string{}
+			}
+
+			groups = append(groups, UserGroup{GroupId: group.GroupId, Roles: roles})
+		}
+
+		state.Groups = groups
+	}
+
+	return true, diags
+}
+
+// syncGroups adds the user to the planned groups and removes it from the groups that are no longer planned
+func (r userResource) syncGroups(sub string, planned []UserGroup, previous []UserGroup) error {
+	for _, group := range planned {
+		index := slices.IndexFunc(previous, func(g UserGroup) bool { return g.GroupId.Equal(group.GroupId) })
+
+		if index >= 0 && sameElements(previous[index].Roles, group.Roles) {
+			continue
+		}
+
+		if err := r.provider.client.AddUserToGroup(group.GroupId.ValueString(), sub, group.Roles); err != nil {
+			return fmt.Errorf("group %s: %w", group.GroupId.ValueString(), err)
+		}
+	}
+
+	for _, group := range previous {
+		if slices.ContainsFunc(planned, func(g UserGroup) bool { return g.GroupId.Equal(group.GroupId) }) {
+			continue
+		}
+
+		if err := r.provider.client.RemoveUserFromGroup(group.GroupId.ValueString(), sub); err != nil {
+			return fmt.Errorf("group %s: %w", group.GroupId.ValueString(), err)
+		}
+	}
+
+	return nil
+}
+
+func planToUser(ctx context.Context, plan *User) (client.User, diag.Diagnostics) {
+	user := client.User{
+		UserStatus:           "VERIFIED",
+		Email:                plan.Email.ValueString(),
+		EmailVerified:        plan.EmailVerified.ValueBool(),
+		MobileNumber:         plan.MobileNumber.ValueString(),
+		MobileNumberVerified: plan.MobileNumberVerified.ValueBool(),
+		Username:             plan.Username.ValueString(),
+		Password:             plan.Password.ValueString(),
+		GivenName:            plan.GivenName.ValueString(),
+		FamilyName:           plan.FamilyName.ValueString(),
+	}
+
+	diags := plan.Roles.ElementsAs(ctx, &user.Roles, false)
+
+	if len(plan.CustomFields) > 0 {
+		user.CustomFields = make(map[string]interface{}, len(plan.CustomFields))
+
+		for key, value := range plan.CustomFields {
+			user.CustomFields[key] = value
+		}
+	}
+
+	return user, diags
+}
+
+func sameElements(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, element := range a {
+		if !slices.Contains(b, element) {
+			return false
+		}
+	}
+
+	return true
+}
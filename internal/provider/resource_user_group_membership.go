@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	membershipModeAdditive  = "additive"
+	membershipModeExclusive = "exclusive"
+)
+
+type userGroupMembershipResource struct {
+	provider *cidaasProvider
+}
+
+var _ resource.Resource = (*userGroupMembershipResource)(nil)
+var _ resource.ResourceWithImportState = (*userGroupMembershipResource)(nil)
+
+func NewUserGroupMembershipResource() resource.Resource {
+	return &userGroupMembershipResource{}
+}
+
+func (r *userGroupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_group_membership"
+}
+
+func (r *userGroupMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.provider, resp.Diagnostics = toProvider(req.ProviderData)
+}
+
+func (r *userGroupMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "`cidaas_user_group_membership` manages the members of a user group and their roles in the group, " +
+			"e.g. of the groups referenced by `cidaas_app.operations_allowed_groups`",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Id of the group, same as group_id",
+			},
+			"group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Id of the group",
+			},
+			"mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(membershipModeAdditive),
+				Validators: []validator.String{
+					stringvalidator.OneOf(membershipModeAdditive, membershipModeExclusive),
+				},
+				Description: "`additive` (default) only manages the listed members, `exclusive` also removes all other " +
+					"members from the group",
+			},
+			"members": schema.SetNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sub": schema.StringAttribute{
+							Required:    true,
+							Description: "Sub of the user, or client id of a service client",
+						},
+						"roles": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "Roles of the member in the group",
+						},
+					},
+				},
+				Description: "Members of the group",
+			},
+		},
+	}
+}
+
+func (r userGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	var plan UserGroupMembership
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncMembers(&plan, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not add members to group", err.Error())
+		return
+	}
+
+	plan.ID = plan.GroupId
+
+	err = r.read(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not read members of group", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r userGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	var state UserGroupMembership
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.read(&state)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not read members of group", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r userGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	var plan UserGroupMembership
+	var state UserGroupMembership
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncMembers(&plan, state.Members)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not update members of group", err.Error())
+		return
+	}
+
+	err = r.read(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not read members of group", err.Error())
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r userGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.provider.isConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	var state UserGroupMembership
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the managed members are removed, even in exclusive mode the group isn't emptied
+	for _, member := range state.Members {
+		err := r.provider.client.RemoveUserFromGroup(state.GroupId.ValueString(), member.Sub.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Could not remove member "+member.Sub.ValueString()+" from group", err.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r userGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// imported memberships are exclusive so that all current members end up in the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mode"), membershipModeExclusive)...)
+}
+
+// read refreshes the members in state. In additive mode only the members already in state are considered, in
+// exclusive mode all members of the group are.
+func (r userGroupMembershipResource) read(state *UserGroupMembership) error {
+	members, err := r.provider.client.ListGroupMembers(state.GroupId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	exclusive := state.Mode.ValueString() == membershipModeExclusive
+	result := []GroupMember{}
+
+	for _, member := range members {
+		if !exclusive && !slices.ContainsFunc(state.Members, func(m GroupMember) bool {
+			return m.Sub.ValueString() == member.Sub
+		}) {
+			continue
+		}
+
+		roles := member.Roles
+		if roles == nil {
+			roles = []string{}
+		}
+
+		result = append(result, GroupMember{Sub: types.StringValue(member.Sub), Roles: roles})
+	}
+
+	state.Members = result
+
+	return nil
+}
+
+// syncMembers adds the planned members to the group and removes the previous members that are no longer planned.
+// In exclusive mode all other members of the group are removed as well.
+func (r userGroupMembershipResource) syncMembers(plan *UserGroupMembership, previous []GroupMember) error {
+	groupId := plan.GroupId.ValueString()
+
+	for _, member := range plan.Members {
+		index := slices.IndexFunc(previous, func(m GroupMember) bool { return m.Sub.Equal(member.Sub) })
+
+		if index >= 0 && sameElements(previous[index].Roles, member.Roles) {
+			continue
+		}
+
+		if err := r.provider.client.AddUserToGroup(groupId, member.Sub.ValueString(), member.Roles); err != nil {
+			return fmt.Errorf("member %s: %w", member.Sub.ValueString(), err)
+		}
+	}
+
+	var removed []string
+
+	for _, member := range previous {
+		removed = append(removed, member.Sub.ValueString())
+	}
+
+	if plan.Mode.ValueString() == membershipModeExclusive {
+		members, err := r.provider.client.ListGroupMembers(groupId)
+		if err != nil {
+			return err
+		}
+
+		for _, member := range members {
+			removed = append(removed, member.Sub)
+		}
+	}
+
+	for i, sub := range removed {
+		if slices.Contains(removed[:i], sub) ||
+			slices.ContainsFunc(plan.Members, func(m GroupMember) bool { return m.Sub.ValueString() == sub }) {
+			continue
+		}
+
+		if err := r.provider.client.RemoveUserFromGroup(groupId, sub); err != nil {
+			return fmt.Errorf("member %s: %w", sub, err)
+		}
+	}
+
+	return nil
+}
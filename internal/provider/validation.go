@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// knownStringList reads a list of strings from the config. The second return value is false if the list or one of
+// its elements is not known yet, in which case validation has to be postponed until apply.
+func knownStringList(ctx context.Context, config tfsdk.Config, p path.Path) ([]string, bool) {
+	var list types.List
+
+	diags := config.GetAttribute(ctx, p, &list)
+	if diags.HasError() || list.IsUnknown() {
+		return nil, false
+	}
+
+	for _, el := range list.Elements() {
+		if el.IsUnknown() {
+			return nil, false
+		}
+	}
+
+	var values []string
+
+	diags = list.ElementsAs(ctx, &values, true)
+	if diags.HasError() {
+		return nil, false
+	}
+
+	return values, true
+}
+
+// isLoopbackHost reports whether host refers to the local machine, where plain http is acceptable.
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// validateSecureURL checks that raw is an absolute URL using https, or http for loopback hosts.
+// Custom schemes, as used by native apps, are only accepted if allowCustomScheme is set.
+func validateSecureURL(raw string, allowCustomScheme bool) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+
+	if !u.IsAbs() {
+		return fmt.Errorf("%s is not an absolute URL", raw)
+	}
+
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if !isLoopbackHost(u.Hostname()) {
+			return fmt.Errorf("%s has to use https, plain http is only allowed for localhost", raw)
+		}
+	default:
+		if !allowCustomScheme {
+			return fmt.Errorf("%s has to use https", raw)
+		}
+
+		return nil
+	}
+
+	if u.Host == "" {
+		return fmt.Errorf("%s does not contain a host", raw)
+	}
+
+	return nil
+}
+
+// validateOrigin checks that raw is a web origin, i.e. a secure URL without path, query or fragment.
+func validateOrigin(raw string) error {
+	err := validateSecureURL(raw, false)
+	if err != nil {
+		return err
+	}
+
+	u, _ := url.Parse(raw)
+
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("%s is not an origin, it must not contain a path, query or fragment", raw)
+	}
+
+	return nil
+}
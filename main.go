@@ -4,8 +4,12 @@ import (
 	"context"
 	"flag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/real-digital/terraform-provider-cidaas/internal/diff"
+	"github.com/real-digital/terraform-provider-cidaas/internal/export"
 	"github.com/real-digital/terraform-provider-cidaas/internal/provider"
+	"io"
 	"log"
+	"os"
 )
 
 var (
@@ -16,6 +20,21 @@ var (
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 func main() {
 
+	if len(os.Args) > 1 {
+		commands := map[string]func([]string, io.Writer) error{
+			"export": export.Run,
+			"diff":   diff.Run,
+		}
+
+		if command, ok := commands[os.Args[1]]; ok {
+			if err := command(os.Args[2:], os.Stdout); err != nil {
+				log.Fatal(err.Error())
+			}
+
+			return
+		}
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")